@@ -22,6 +22,7 @@ import (
 	inspect "github.com/matrixorigin/matrixone/cmd/mo-inspect"
 	object "github.com/matrixorigin/matrixone/cmd/mo-object-tool"
 	ckp "github.com/matrixorigin/matrixone/cmd/mo-object-tool/ckp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/cmd/tpchbench"
 	"github.com/spf13/cobra"
 )
 
@@ -37,6 +38,7 @@ func main() {
 	rootCmd.AddCommand(dashboard.PrepareCommand())
 	rootCmd.AddCommand(object.PrepareCommand())
 	rootCmd.AddCommand(ckp.PrepareCommand())
+	rootCmd.AddCommand(tpchbench.PrepareCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
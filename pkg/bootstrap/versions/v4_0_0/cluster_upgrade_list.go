@@ -29,6 +29,12 @@ var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_mo_iscp_task,
 	upg_mo_index_update_new,
 	upg_create_mo_branch_metadata,
+	upg_create_mo_branch_orphans,
+	upg_add_mo_branch_metadata_last_read_ts,
+	upg_add_mo_branch_metadata_last_write_ts,
+	upg_add_mo_branch_metadata_read_count,
+	upg_add_mo_branch_metadata_write_count,
+	upg_create_mo_branch_status,
 	upg_rename_system_stmt_info_4000,
 	upg_create_system_stmt_info_4000,
 	upg_rename_system_metrics_metric_4000,
@@ -81,6 +87,98 @@ var upg_create_mo_branch_metadata = versions.UpgradeEntry{
 	},
 }
 
+var upg_create_mo_branch_orphans = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_branch_orphans",
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    frontend.MoCatalogMoBranchOrphansDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, "mo_branch_orphans")
+		return exists, err
+	},
+	PreSql: fmt.Sprintf("DROP VIEW IF EXISTS %s.mo_branch_orphans;", catalog.MO_CATALOG),
+}
+
+var upg_add_mo_branch_metadata_last_read_ts = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_BRANCH_METADATA,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql: fmt.Sprintf(
+		"alter table %s.%s add column last_read_ts bigint signed not null default 0",
+		catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA,
+	),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		info, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA, "last_read_ts")
+		if err != nil {
+			return false, err
+		}
+		return info.IsExits, nil
+	},
+}
+
+var upg_add_mo_branch_metadata_last_write_ts = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_BRANCH_METADATA,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql: fmt.Sprintf(
+		"alter table %s.%s add column last_write_ts bigint signed not null default 0",
+		catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA,
+	),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		info, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA, "last_write_ts")
+		if err != nil {
+			return false, err
+		}
+		return info.IsExits, nil
+	},
+}
+
+var upg_add_mo_branch_metadata_read_count = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_BRANCH_METADATA,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql: fmt.Sprintf(
+		"alter table %s.%s add column read_count bigint unsigned not null default 0",
+		catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA,
+	),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		info, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA, "read_count")
+		if err != nil {
+			return false, err
+		}
+		return info.IsExits, nil
+	},
+}
+
+var upg_add_mo_branch_metadata_write_count = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_BRANCH_METADATA,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql: fmt.Sprintf(
+		"alter table %s.%s add column write_count bigint unsigned not null default 0",
+		catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA,
+	),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		info, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA, "write_count")
+		if err != nil {
+			return false, err
+		}
+		return info.IsExits, nil
+	},
+}
+
+var upg_create_mo_branch_status = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_branch_status",
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    frontend.MoCatalogMoBranchStatusDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, "mo_branch_status")
+		return exists, err
+	},
+	PreSql: fmt.Sprintf("DROP VIEW IF EXISTS %s.mo_branch_status;", catalog.MO_CATALOG),
+}
+
 var upg_create_mo_feature_limit = versions.UpgradeEntry{
 	Schema:    catalog.MO_CATALOG,
 	TableName: catalog.MO_FEATURE_LIMIT,
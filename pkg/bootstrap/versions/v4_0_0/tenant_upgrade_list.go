@@ -27,6 +27,8 @@ var tenantUpgEntries = []versions.UpgradeEntry{
 	enablePartitionMetadata,
 	enablePartitionTables,
 	upg_alter_mo_snapshots,
+	upg_alter_mo_snapshots_add_created_by,
+	upg_alter_mo_snapshots_add_comment,
 }
 
 var enablePartitionMetadata = versions.UpgradeEntry{
@@ -70,3 +72,42 @@ var upg_alter_mo_snapshots = versions.UpgradeEntry{
 		return info.IsExits, nil
 	},
 }
+
+const createdBy = "created_by"
+const comment = "comment"
+
+var upg_alter_mo_snapshots_add_created_by = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_SNAPSHOTS,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql: fmt.Sprintf(
+		"alter table %s.%s add column %s varchar(300) not null default ''",
+		catalog.MO_CATALOG, catalog.MO_SNAPSHOTS, createdBy,
+	),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		info, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_SNAPSHOTS, createdBy)
+		if err != nil {
+			return false, err
+		}
+
+		return info.IsExits, nil
+	},
+}
+
+var upg_alter_mo_snapshots_add_comment = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_SNAPSHOTS,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql: fmt.Sprintf(
+		"alter table %s.%s add column %s varchar(5000) not null default ''",
+		catalog.MO_CATALOG, catalog.MO_SNAPSHOTS, comment,
+	),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		info, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_SNAPSHOTS, comment)
+		if err != nil {
+			return false, err
+		}
+
+		return info.IsExits, nil
+	},
+}
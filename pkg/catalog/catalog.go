@@ -202,6 +202,13 @@ func BuildProfilePath(serviceTyp string, nodeId string, typ, name string) string
 	return fmt.Sprintf("%s/%s_%s_%s_%s", ProfileDir, serviceTyp, nodeId, typ, name)
 }
 
+// BuildDiagnosticsPath builds the path of a postmortem diagnostics bundle
+// dumped on OOM/crash, named so that lexical order matches chronological
+// order (for retention scans that keep only the newest bundles).
+func BuildDiagnosticsPath(serviceTyp string, nodeId string, timestamp string, reason string) string {
+	return fmt.Sprintf("%s/%s_%s_%s_%s.txt", DiagnosticsDir, timestamp, serviceTyp, nodeId, reason)
+}
+
 func IsFakePkName(name string) bool {
 	return name == FakePrimaryKeyColName
 }
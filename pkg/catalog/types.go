@@ -179,6 +179,8 @@ const (
 	MO_BRANCH_METADATA  = "mo_branch_metadata"
 	MO_FEATURE_LIMIT    = "mo_feature_limit"
 	MO_FEATURE_REGISTRY = "mo_feature_registry"
+
+	MO_LIFECYCLE_EVENTS = "mo_lifecycle_events"
 )
 
 func IsSystemTable(id uint64) bool {
@@ -244,6 +246,11 @@ const (
 	SystemRelAttr_ExtraInfo      = "extra_info"
 	SystemRelAttr_CPKey          = CPrimaryKeyColName
 	SystemRelAttr_LogicalID      = "rel_logical_id"
+	SystemRelAttr_AppendOnly     = "append_only"
+	// SystemRelAttr_ColumnGroups declares which storage column group each
+	// column belongs to, as "group1:col1,col2;group2:col3". Columns left
+	// out of every group stay in the table's default group.
+	SystemRelAttr_ColumnGroups = "column_groups"
 
 	// 'mo_indexes' table
 	IndexAlgoName      = "algo"
@@ -801,6 +808,8 @@ var (
 	//ProfileDir holds all profiles dumped by the runtime/pprof
 	ProfileDir string
 	TraceDir   string
+	//DiagnosticsDir holds postmortem diagnostics bundles dumped on OOM/crash
+	DiagnosticsDir string
 )
 
 func init() {
@@ -809,6 +818,7 @@ func init() {
 	QueryResultMetaDir = fileservice.JoinPath(defines.SharedFileServiceName, "/query_result_meta")
 	ProfileDir = fileservice.JoinPath(defines.ETLFileServiceName, "/profile")
 	TraceDir = fileservice.JoinPath(defines.ETLFileServiceName, "/trace")
+	DiagnosticsDir = fileservice.JoinPath(defines.ETLFileServiceName, "/diagnostics")
 }
 
 type Meta struct {
@@ -672,6 +672,12 @@ var GetTableDef = func(
 const (
 	InitKeyId           = "4e3da275-5003-4ca0-8667-5d3cdbecdd35"
 	InsertDataKeyFormat = "replace into mo_catalog.mo_data_key (account_id, key_id, encrypted_key) values (%d, '%s', '%s')"
+	// InsertDataKeyIfNotExistsFormat provisions an account's first data key
+	// without clobbering one a concurrent first-use already committed: unlike
+	// InsertDataKeyFormat's "replace into", "insert ignore" leaves an
+	// existing (account_id, key_id) row alone instead of silently swapping
+	// out a key that may already have encrypted something.
+	InsertDataKeyIfNotExistsFormat = "insert ignore into mo_catalog.mo_data_key (account_id, key_id, encrypted_key) values (%d, '%s', '%s')"
 )
 
 var AesKey string
@@ -758,6 +764,26 @@ func GetInitDataKeySql(kek string) (_ string, err error) {
 	return fmt.Sprintf(InsertDataKeyFormat, catalog.System_Account, InitKeyId, encryptedKey), nil
 }
 
+// GetAccountDataKeySql is GetInitDataKeySql for an arbitrary tenant: it
+// returns the SQL to provision accountId its own random data key, encrypted
+// under kek, instead of accountId implicitly sharing the system account's
+// key. Callers that need tenant data keys cryptographically separated from
+// each other (rather than just from the KEK) should provision one per
+// account through this instead of reusing InitKeyId under System_Account.
+func GetAccountDataKeySql(accountId uint32, kek string) (_ string, err error) {
+	aesKey := make([]byte, 32)
+	if _, err = cryptoRandRead(aesKey); err != nil {
+		return
+	}
+
+	encryptedKey, err := encrypt(aesKey, []byte(kek))
+	if err != nil {
+		return
+	}
+
+	return fmt.Sprintf(InsertDataKeyIfNotExistsFormat, accountId, InitKeyId, encryptedKey), nil
+}
+
 func batchRowCount(bat *batch.Batch) int {
 	if bat == nil || len(bat.Vecs) == 0 {
 		return 0
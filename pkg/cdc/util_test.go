@@ -922,6 +922,26 @@ func TestGetInitDataKeySql(t *testing.T) {
 	}
 }
 
+func TestGetAccountDataKeySql(t *testing.T) {
+	{
+		stub := gostub.Stub(&cryptoRandRead, func([]byte) (int, error) {
+			return 0, moerr.NewInternalErrorNoCtx("")
+		})
+		_, err := GetAccountDataKeySql(123, "01234567890123456789012345678901")
+		assert.Error(t, err)
+		stub.Reset()
+	}
+	{
+		stub := gostub.Stub(&encrypt, func(data []byte, aesKey []byte) (string, error) {
+			return "encrypted", nil
+		})
+		s, err := GetAccountDataKeySql(123, "01234567890123456789012345678901")
+		assert.NoError(t, err)
+		assert.Equal(t, "insert ignore into mo_catalog.mo_data_key (account_id, key_id, encrypted_key) values (123, '4e3da275-5003-4ca0-8667-5d3cdbecdd35', 'encrypted')", s)
+		stub.Reset()
+	}
+}
+
 func TestAesCFBEncodeWithKey_EmptyKey(t *testing.T) {
 	_, err := aesCFBEncodeWithKey([]byte("01234567890123456789012345678901"), []byte{})
 	assert.Error(t, err)
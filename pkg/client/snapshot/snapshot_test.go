@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("create snapshot if not exists sp1 for account acc1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	c := NewClient(db)
+	err = c.CreateSnapshot(context.Background(), "sp1", Object{Level: LevelAccount, Account: "acc1"}, true)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSnapshotRejectsIncompleteObject(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	c := NewClient(db)
+	err = c.CreateSnapshot(context.Background(), "sp1", Object{Level: LevelTable, Database: "d1"}, false)
+	require.Error(t, err)
+}
+
+func TestDropSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("drop snapshot if exists sp1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	c := NewClient(db)
+	require.NoError(t, c.DropSnapshot(context.Background(), "sp1", true))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShowSnapshots(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"SNAPSHOT_NAME", "TIMESTAMP", "SNAPSHOT_LEVEL", "ACCOUNT_NAME", "DATABASE_NAME", "TABLE_NAME"}).
+		AddRow("sp1", "2026-01-02 03:04:05", "account", "acc1", "", "").
+		AddRow("sp2", "2026-01-03 03:04:05", "table", "acc1", "d1", "t1")
+	mock.ExpectQuery("show snapshots").WillReturnRows(rows)
+
+	c := NewClient(db)
+	got, err := c.ShowSnapshots(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "sp1", got[0].Name)
+	require.Equal(t, "account", got[0].Level)
+	require.Equal(t, "acc1", got[0].Account)
+	require.Equal(t, 2026, got[0].Timestamp.Year())
+	require.Equal(t, "t1", got[1].Table)
+}
+
+// TestShowSnapshotsIgnoresUnknownColumns exercises the scenario the request
+// called out: a server that returns an extra column SHOW SNAPSHOTS didn't
+// used to have must not break scanning the columns this client knows about.
+func TestShowSnapshotsIgnoresUnknownColumns(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"SNAPSHOT_NAME", "TIMESTAMP", "SNAPSHOT_LEVEL", "ACCOUNT_NAME", "DATABASE_NAME", "TABLE_NAME", "NEW_COLUMN"}).
+		AddRow("sp1", "2026-01-02 03:04:05", "cluster", "", "", "", "future-value")
+	mock.ExpectQuery("show snapshots").WillReturnRows(rows)
+
+	c := NewClient(db)
+	got, err := c.ShowSnapshots(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "sp1", got[0].Name)
+	require.Equal(t, "cluster", got[0].Level)
+}
+
+func TestShowSnapshotsWithWhere(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("show snapshots where account_name = 'acc1'").
+		WillReturnRows(sqlmock.NewRows([]string{"SNAPSHOT_NAME", "TIMESTAMP", "SNAPSHOT_LEVEL", "ACCOUNT_NAME", "DATABASE_NAME", "TABLE_NAME"}))
+
+	c := NewClient(db)
+	got, err := c.ShowSnapshots(context.Background(), "account_name = 'acc1'")
+	require.NoError(t, err)
+	require.Len(t, got, 0)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePitr(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("create pitr p1 for database d1 range 1 d").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	c := NewClient(db)
+	err = c.CreatePitr(context.Background(), "p1", Object{Level: LevelDatabase, Database: "d1"}, 1, "d", false)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShowPitr(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"PITR_NAME", "CREATED_TIME", "MODIFIED_TIME", "PITR_LEVEL", "ACCOUNT_NAME", "DATABASE_NAME", "TABLE_NAME", "PITR_LENGTH", "PITR_UNIT"}).
+		AddRow("p1", "2026-01-02 03:04:05", "2026-01-02 03:04:05", "database", "acc1", "d1", "", 1, "d")
+	mock.ExpectQuery("show pitr").WillReturnRows(rows)
+
+	c := NewClient(db)
+	got, err := c.ShowPitr(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "p1", got[0].Name)
+	require.Equal(t, int64(1), got[0].RangeValue)
+	require.Equal(t, "d", got[0].RangeUnit)
+}
+
+func TestDropPitr(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("drop pitr p1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	c := NewClient(db)
+	require.NoError(t, c.DropPitr(context.Background(), "p1", false))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
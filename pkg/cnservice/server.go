@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"runtime/debug"
 	"sync"
 	"time"
 
@@ -204,6 +205,21 @@ func NewService(
 		rscthrottler.WithAcquirePolicy(rscthrottler.AcquirePolicyForCNFlushS3),
 	)
 
+	srv.memPressureMonitor = rscthrottler.NewPressureMonitor(
+		rscthrottler.NewMemThrottler("CNMemPressure", 90.0/100.0),
+	)
+	srv.memPressureMonitor.RegisterShrinkHook("fileservice-memory-cache", func(ctx context.Context) int64 {
+		var freed int64
+		for _, target := range fileservice.EvictMemoryCaches(ctx) {
+			freed += target
+		}
+		return freed
+	})
+	srv.memPressureMonitor.RegisterShrinkHook("go-runtime-free-os-memory", func(ctx context.Context) int64 {
+		debug.FreeOSMemory()
+		return 0
+	})
+
 	srv.pu.LockService = srv.lockService
 	srv.pu.HAKeeperClient = srv._hakeeperClient
 	srv.pu.QueryClient = srv.queryClient
@@ -264,6 +280,12 @@ func (s *service) Start() error {
 		return err
 	}
 
+	if err := s.stopper.RunNamedTask("cnservice-mem-pressure-monitor", func(ctx context.Context) {
+		s.memPressureMonitor.Run(ctx)
+	}); err != nil {
+		return err
+	}
+
 	err := s.runMoServer()
 	if err != nil {
 		return err
@@ -689,7 +689,8 @@ type service struct {
 		client  cnclient.PipelineClient
 	}
 
-	CNMemoryThrottler rscthrottler.RSCThrottler
+	CNMemoryThrottler  rscthrottler.RSCThrottler
+	memPressureMonitor *rscthrottler.PressureMonitor
 }
 
 func dumpCnConfig(cfg Config) (map[string]*logservicepb.ConfigItem, error) {
@@ -78,6 +78,21 @@ func (n *Bitmap) Clone() *Bitmap {
 	return &res
 }
 
+// Slice returns a copy of n with every bit outside [start, end) cleared.
+// It is meant for narrowing an already-computed bitmap down to the range a
+// caller actually cares about, not for avoiding the cost of computing n.
+func (n *Bitmap) Slice(start, end uint64) *Bitmap {
+	res := n.Clone()
+	if res == nil {
+		return nil
+	}
+	if start > 0 {
+		res.RemoveRange(0, start)
+	}
+	res.RemoveRange(end, uint64(res.len))
+	return res
+}
+
 func (n *Bitmap) Iterator() Iterator {
 	// When initialization, the itr.i is set to the first rightmost_one position.
 	itr := BitmapIterator{i: 0, bm: n}
@@ -186,6 +186,19 @@ func TestBitmap_Clear(t *testing.T) {
 	require.Equal(t, 0, np.Count())
 }
 
+func TestBitmap_Slice(t *testing.T) {
+	np := newBm(BenchmarkRows)
+	np.AddRange(100, 1000)
+	sliced := np.Slice(200, 300)
+	require.Equal(t, 100, sliced.Count())
+	require.False(t, sliced.Contains(199))
+	require.True(t, sliced.Contains(200))
+	require.True(t, sliced.Contains(299))
+	require.False(t, sliced.Contains(300))
+	// the original bitmap is untouched
+	require.Equal(t, 900, np.Count())
+}
+
 func TestBitmap_Or(t *testing.T) {
 	np := newBm(BenchmarkRows)
 	np.AddRange(100, 1000)
@@ -0,0 +1,49 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package collate provides collation-aware string comparison kernels.
+//
+// MatrixOne's storage, zonemap and index layers compare strings byte-wise,
+// which is correct for the "binary"/"*_bin" collations but breaks MySQL
+// compatibility for case-insensitive ones such as utf8mb4_general_ci and
+// utf8mb4_unicode_ci. This package does not depend on ICU (unavailable in
+// this build) and therefore only implements simple case-folding comparison,
+// which covers the common *_general_ci/*_unicode_ci case but not
+// locale-specific tailoring (accent weighting, expansions, etc.).
+package collate
+
+import "unicode"
+
+// CompareFold compares s1 and s2 rune by rune after Unicode case-folding,
+// the same ordering MySQL's *_ci collations apply to ASCII and most Latin
+// text. It returns a negative number, zero, or a positive number depending
+// on whether s1 is less than, equal to, or greater than s2.
+func CompareFold(s1, s2 string) int {
+	r1, r2 := []rune(s1), []rune(s2)
+	for i := 0; i < len(r1) && i < len(r2); i++ {
+		c1, c2 := unicode.ToLower(r1[i]), unicode.ToLower(r2[i])
+		if c1 != c2 {
+			if c1 < c2 {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(r1) - len(r2)
+}
+
+// EqualFold reports whether s1 and s2 are equal under case-folding.
+func EqualFold(s1, s2 string) bool {
+	return CompareFold(s1, s2) == 0
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import "testing"
+
+func TestCompareFold(t *testing.T) {
+	cases := []struct {
+		s1, s2 string
+		want   int
+	}{
+		{"abc", "ABC", 0},
+		{"Abc", "abd", -1},
+		{"abd", "Abc", 1},
+		{"ab", "abc", -1},
+		{"abc", "ab", 1},
+	}
+	for _, c := range cases {
+		got := CompareFold(c.s1, c.s2)
+		if (got < 0 && c.want >= 0) || (got > 0 && c.want <= 0) || (got == 0 && c.want != 0) {
+			t.Fatalf("CompareFold(%q, %q) = %d, want sign of %d", c.s1, c.s2, got, c.want)
+		}
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	if !EqualFold("utf8mb4_general_ci", "UTF8MB4_GENERAL_CI") {
+		t.Fatal("expected case-fold equality")
+	}
+	if EqualFold("abc", "abd") {
+		t.Fatal("expected inequality")
+	}
+}
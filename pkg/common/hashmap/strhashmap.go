@@ -63,6 +63,17 @@ func (m *StrHashMap) HasNull() bool {
 
 func (m *StrHashMap) Free() {
 	m.hashMap.Free()
+	m.intern = nil
+}
+
+// internTable lazily creates the StrHashMap's prefix-intern cache used to
+// assign small surrogate codes to repeated long string group-by values. See
+// fillInternedGroupStr.
+func (m *StrHashMap) internTable() *prefixInternTable {
+	if m.intern == nil {
+		m.intern = newPrefixInternTable()
+	}
+	return m.intern
 }
 
 func (m *StrHashMap) PreAlloc(n uint64) error {
@@ -93,6 +104,10 @@ func (itr *strHashmapIterator) encodeHashKeys(vecs []*vector.Vector, start, coun
 	for _, vec := range vecs {
 		if vec.GetType().IsFixedLen() {
 			fillGroupStr(itr, vec, count, vec.GetType().TypeSize(), start, 0, len(vecs))
+		} else if len(vecs) == 1 {
+			// single-column group-by keys are the common label-style case,
+			// and the one fillInternedGroupStr is scoped to handle.
+			fillInternedGroupStr(itr, vec, count, start)
 		} else {
 			fillStringGroupStr(itr, vec, count, start, len(vecs))
 		}
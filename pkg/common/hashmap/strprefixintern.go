@@ -0,0 +1,140 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashmap
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	// prefixInternKeyLen is the level-1 key of prefixInternTable: values are
+	// bucketed by their first prefixInternKeyLen bytes (or fewer, if shorter).
+	// Two values sharing a prefix land in the same bucket and are only then
+	// told apart by comparing their full bytes (level 2).
+	prefixInternKeyLen = 8
+
+	// minInternValueLen is the shortest value that's worth interning. Below
+	// it, the underlying hash table hashing the raw bytes directly is already
+	// cheap enough that an intern table lookup wouldn't pay for itself.
+	minInternValueLen = 32
+
+	// internedTag marks an interned key in the encoded group-by key, distinct
+	// from the 0 (plain value), 1 (null) and 2 (grouping-set) tags used by
+	// fillStringGroupStr.
+	internedTag = byte(3)
+)
+
+// prefixInternEntry records one interned value and the surrogate code
+// fillInternedGroupStr substitutes for it.
+type prefixInternEntry struct {
+	value []byte
+	code  uint64
+}
+
+// prefixInternTable is a two-level lookup that turns repeated long string
+// group-by values into small fixed-size surrogate codes: a Go map buckets
+// values by a short fixed-length prefix (level 1), and a short slice scan
+// within the bucket compares full values to find, or assign, the surrogate
+// code for a value (level 2). Once interned, the underlying
+// hashtable.StringHashMap hashes and compares an 8-byte code instead of
+// re-hashing the same long string on every repeated occurrence, which is the
+// common case for label-style group-by columns.
+//
+// It is a pure in-memory speedup, scoped to a single StrHashMap instance: it
+// is never persisted by MarshalBinary/WriteTo and is simply rebuilt (empty)
+// on UnmarshalBinary/UnmarshalFrom, since re-interning on first use after a
+// restore is no more expensive than interning on first use ever was.
+type prefixInternTable struct {
+	buckets map[string][]prefixInternEntry
+	next    uint64
+}
+
+func newPrefixInternTable() *prefixInternTable {
+	return &prefixInternTable{buckets: make(map[string][]prefixInternEntry)}
+}
+
+// intern returns the surrogate code for value, assigning a new one the first
+// time a distinct value is seen. The caller's value slice is not retained.
+func (t *prefixInternTable) intern(value []byte) uint64 {
+	prefix := value
+	if len(prefix) > prefixInternKeyLen {
+		prefix = prefix[:prefixInternKeyLen]
+	}
+	bucket := t.buckets[string(prefix)]
+	for _, e := range bucket {
+		if bytes.Equal(e.value, value) {
+			return e.code
+		}
+	}
+	t.next++
+	owned := make([]byte, len(value))
+	copy(owned, value)
+	t.buckets[string(prefix)] = append(bucket, prefixInternEntry{value: owned, code: t.next})
+	return t.next
+}
+
+// fillInternedGroupStr is the fast path encodeHashKeys takes for a single
+// varlen group-by column: values long enough to benefit (see
+// minInternValueLen) are interned via mp.internTable, and the resulting code
+// -- not the raw bytes -- becomes the hash table key. Nulls still take the
+// existing tag-1 encoding. Anything that doesn't fit this simple shape
+// (grouping sets, a const vector, or nulls in a not-hasNull map, where a null
+// row must be excluded via zValues instead of tagged) falls back to
+// fillStringGroupStr untouched.
+func fillInternedGroupStr(itr *strHashmapIterator, vec *vector.Vector, lenV int, start int) {
+	if vec.IsConstNull() || vec.IsConst() {
+		fillStringGroupStr(itr, vec, lenV, start, 1)
+		return
+	}
+	if !vec.GetGrouping().IsEmpty() {
+		fillStringGroupStr(itr, vec, lenV, start, 1)
+		return
+	}
+	nsp := vec.GetNulls()
+	hasNulls := nsp.Any()
+	if hasNulls && !itr.mp.hasNull {
+		fillStringGroupStr(itr, vec, lenV, start, 1)
+		return
+	}
+
+	keys := itr.keys
+	va, area := vector.MustVarlenaRawData(vec)
+	intern := itr.mp.internTable()
+	for i := 0; i < lenV; i++ {
+		if hasNulls && nsp.Contains(uint64(i+start)) {
+			keys[i] = append(keys[i], byte(1))
+			continue
+		}
+		var value []byte
+		if area == nil {
+			value = va[i+start].ByteSlice()
+		} else {
+			value = va[i+start].GetByteSlice(area)
+		}
+		if len(value) < minInternValueLen {
+			length := uint16(len(value))
+			keys[i] = append(keys[i], 0)
+			keys[i] = append(keys[i], types.EncodeUint16(&length)...)
+			keys[i] = append(keys[i], value...)
+			continue
+		}
+		code := intern.intern(value)
+		keys[i] = append(keys[i], internedTag)
+		keys[i] = append(keys[i], types.EncodeUint64(&code)...)
+	}
+}
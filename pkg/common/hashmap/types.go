@@ -87,6 +87,9 @@ type StrHashMap struct {
 	hasNull bool
 	rows    uint64
 	hashMap *hashtable.StringHashMap
+	// intern caches surrogate codes for repeated long group-by values; see
+	// prefixInternTable. Lazily created, and never persisted.
+	intern *prefixInternTable
 }
 
 // IntHashMap key is int64, value is an uint64 (start from 1)
@@ -231,6 +231,9 @@ var (
 	CauseAddressFunc = NewInternalError(context.Background(), "AddressFunc")
 	//pkg/util/export/etl/db
 	CauseWriteRowRecords = NewInternalError(context.Background(), "WriteRowRecords")
+	//pkg/util/diagnostics
+	CauseSaveDiagnosticsBundle = NewInternalError(context.Background(), "save diagnostics bundle")
+	CausePruneDiagnostics      = NewInternalError(context.Background(), "prune diagnostics bundles")
 	//pkg/util/file
 	CauseReadFile  = NewInternalError(context.Background(), "ReadFile")
 	CauseWriteFile = NewInternalError(context.Background(), "WriteFile")
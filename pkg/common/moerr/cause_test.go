@@ -222,6 +222,9 @@ var causeArray = []error{
 
 	CauseWriteRowRecords,
 
+	CauseSaveDiagnosticsBundle,
+	CausePruneDiagnostics,
+
 	CauseReadFile,
 	CauseWriteFile,
 
@@ -0,0 +1,166 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rscthrottler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPressureMonitorInterval = time.Second * 15
+
+	defaultHighWatermark = 0.85
+	defaultLowWatermark  = 0.70
+)
+
+// ShrinkHook is a named, best-effort callback the PressureMonitor runs when
+// memory pressure crosses the high watermark. It returns the number of
+// bytes it managed to free, for logging only.
+type ShrinkHook struct {
+	Name string
+	Fn   func(ctx context.Context) int64
+}
+
+// PressureMonitor periodically samples a RSCThrottler's Pressure() and, once
+// RSS gets close to the cgroup/host memory limit, runs the registered shrink
+// hooks (e.g. evicting file service caches, returning freed memory to the
+// OS). While pressure stays at or above the high watermark, Paused()
+// reports true so that callers elsewhere in the process can use it as a
+// signal to hold off on low-priority background work; it clears once
+// pressure drops back to or below the low watermark. The gap between the
+// two watermarks is there so the monitor doesn't flap every tick.
+type PressureMonitor struct {
+	throttler RSCThrottler
+	interval  time.Duration
+	high      float64
+	low       float64
+
+	hooksMu sync.Mutex
+	hooks   []ShrinkHook
+
+	paused atomic.Bool
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+type PressureMonitorOption func(*PressureMonitor)
+
+func WithPressureMonitorInterval(d time.Duration) PressureMonitorOption {
+	return func(m *PressureMonitor) { m.interval = d }
+}
+
+func WithPressureWatermarks(high, low float64) PressureMonitorOption {
+	return func(m *PressureMonitor) { m.high = high; m.low = low }
+}
+
+func NewPressureMonitor(throttler RSCThrottler, opts ...PressureMonitorOption) *PressureMonitor {
+	m := &PressureMonitor{
+		throttler: throttler,
+		interval:  defaultPressureMonitorInterval,
+		high:      defaultHighWatermark,
+		low:       defaultLowWatermark,
+		stopCh:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// RegisterShrinkHook adds a callback run, in registration order, every time
+// pressure is sampled at or above the high watermark. Hooks run sequentially
+// on the monitor's own goroutine and shouldn't block for long.
+func (m *PressureMonitor) RegisterShrinkHook(name string, fn func(ctx context.Context) int64) {
+	m.hooksMu.Lock()
+	defer m.hooksMu.Unlock()
+	m.hooks = append(m.hooks, ShrinkHook{Name: name, Fn: fn})
+}
+
+// Paused reports whether pressure is currently at or above the high
+// watermark.
+func (m *PressureMonitor) Paused() bool {
+	return m.paused.Load()
+}
+
+// Run samples pressure every interval until ctx is done or Stop is called.
+// It's meant to be driven by a long-running background task, e.g. one
+// registered with a stopper.
+func (m *PressureMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+			m.tick(ctx)
+		}
+	}
+}
+
+func (m *PressureMonitor) Stop() {
+	m.stopOnce.Do(func() { close(m.stopCh) })
+}
+
+func (m *PressureMonitor) tick(ctx context.Context) {
+	pressure := m.throttler.Pressure()
+	v2.MemPressureGauge.Set(pressure)
+
+	switch {
+	case pressure >= m.high:
+		wasPaused := m.paused.Swap(true)
+		if !wasPaused {
+			v2.MemPressureEventCounter.WithLabelValues("enter").Inc()
+		}
+		m.shrink(ctx, pressure)
+	case pressure <= m.low:
+		if wasPaused := m.paused.Swap(false); wasPaused {
+			v2.MemPressureEventCounter.WithLabelValues("exit").Inc()
+			logutil.Info(
+				"MemPressureMonitor-Resume",
+				zap.Float64("pressure", pressure),
+			)
+		}
+	}
+}
+
+func (m *PressureMonitor) shrink(ctx context.Context, pressure float64) {
+	m.hooksMu.Lock()
+	hooks := append([]ShrinkHook(nil), m.hooks...)
+	m.hooksMu.Unlock()
+
+	var freed int64
+	for _, hook := range hooks {
+		freed += hook.Fn(ctx)
+		v2.MemPressureShrinkCounter.WithLabelValues(hook.Name).Inc()
+	}
+
+	logutil.Info(
+		"MemPressureMonitor-Shrink",
+		zap.Float64("pressure", pressure),
+		zap.Int64("freed", freed),
+	)
+}
@@ -42,6 +42,10 @@ type RSCThrottler interface {
 	Acquire(int64) (int64, bool)
 	Release(int64) int64
 	Available() int64
+	// Pressure returns how close the process RSS is to the cgroup/host
+	// memory limit, as a ratio in [0, 1]. It refreshes the underlying
+	// sample first, subject to the same debounce as Refresh.
+	Pressure() float64
 }
 
 type memThrottler struct {
@@ -207,6 +211,22 @@ func (m *memThrottler) Available() int64 {
 	return max(0, avail)
 }
 
+// Pressure reports rss/actualTotalMemory, i.e. how close the process is to
+// the cgroup limit (or the host's total memory, when there's no tighter
+// cgroup limit). It's independent of the throttler's own configured limit
+// and reservations, which only bound what this particular throttler will
+// hand out.
+func (m *memThrottler) Pressure() float64 {
+	m.Refresh()
+
+	actualMaxMemory := m.actualTotalMemory.Load()
+	if actualMaxMemory == 0 || actualMaxMemory == math.MaxInt64 {
+		return 0
+	}
+
+	return float64(m.rss.Load()) / float64(actualMaxMemory)
+}
+
 func (m *memThrottler) PrintUsage() {
 	logutil.Info(
 		fmt.Sprintf("%s-Usage", MemoryThrottlerLogHeader),
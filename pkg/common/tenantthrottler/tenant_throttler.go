@@ -0,0 +1,146 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenantthrottler provides a per-account token bucket, so that
+// background IO consumers shared across tenants (merge, flush, GC) can be
+// weighted fairly instead of letting one account's backlog starve another's
+// on the same node.
+package tenantthrottler
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultWeight is the weight assigned to an account that has none configured.
+const DefaultWeight = 1.0
+
+type bucket struct {
+	weight   float64
+	tokens   float64
+	lastFill time.Time
+}
+
+// TenantIOScheduler hands out IO "tokens" (an abstract unit, typically bytes)
+// to accounts on a weighted, refilling basis. A caller asks for a budget via
+// Acquire before doing IO work and skips or defers the work if denied; there
+// is no blocking wait and no explicit Release, since buckets refill purely
+// from elapsed time, mirroring how rscthrottler.RSCThrottler is driven from
+// the merge scheduler's polling loop rather than from blocking calls.
+type TenantIOScheduler struct {
+	mu sync.Mutex
+
+	// ratePerWeight is how many tokens a weight-1.0 account accrues per second.
+	ratePerWeight float64
+	// capPerWeight bounds how many tokens a weight-1.0 account can bank up.
+	capPerWeight float64
+
+	buckets map[uint32]*bucket
+
+	now func() time.Time
+}
+
+// NewTenantIOScheduler creates a scheduler where an account with the default
+// weight of 1.0 can sustain ratePerWeight tokens/sec, bursting up to
+// capPerWeight banked tokens.
+func NewTenantIOScheduler(ratePerWeight, capPerWeight float64) *TenantIOScheduler {
+	return &TenantIOScheduler{
+		ratePerWeight: ratePerWeight,
+		capPerWeight:  capPerWeight,
+		buckets:       make(map[uint32]*bucket),
+		now:           time.Now,
+	}
+}
+
+func (s *TenantIOScheduler) bucketFor(accountID uint32) *bucket {
+	b, ok := s.buckets[accountID]
+	if !ok {
+		b = &bucket{weight: DefaultWeight, lastFill: s.now()}
+		b.tokens = b.weight * s.capPerWeight
+		s.buckets[accountID] = b
+	}
+	return b
+}
+
+func (s *TenantIOScheduler) refill(b *bucket, at time.Time) {
+	elapsed := at.Sub(b.lastFill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.lastFill = at
+	b.tokens += elapsed * b.weight * s.ratePerWeight
+	if cap := b.weight * s.capPerWeight; b.tokens > cap {
+		b.tokens = cap
+	}
+}
+
+// SetWeight changes an account's share of the shared IO budget. Heavier
+// weights earn tokens, and may bank them, proportionally faster. Banked
+// tokens are rescaled by the same factor as the weight change, so a bucket
+// set to a new weight before its first refill still starts at the new
+// weight's full cap rather than staying pinned to the old weight's.
+func (s *TenantIOScheduler) SetWeight(accountID uint32, weight float64) {
+	if weight <= 0 {
+		weight = DefaultWeight
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.bucketFor(accountID)
+	if b.weight > 0 {
+		b.tokens *= weight / b.weight
+	}
+	b.weight = weight
+	if cap := b.weight * s.capPerWeight; b.tokens > cap {
+		b.tokens = cap
+	}
+}
+
+// Acquire tries to withdraw tokens tokens from accountID's bucket, refilling
+// it first. It returns false (consuming nothing) if the bucket doesn't have
+// enough banked tokens yet.
+func (s *TenantIOScheduler) Acquire(accountID uint32, tokens float64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.bucketFor(accountID)
+	s.refill(b, s.now())
+	if b.tokens < tokens {
+		return false
+	}
+	b.tokens -= tokens
+	return true
+}
+
+// Available reports how many tokens accountID currently has banked, after
+// refilling. Useful for metrics/diagnostics.
+func (s *TenantIOScheduler) Available(accountID uint32) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.bucketFor(accountID)
+	s.refill(b, s.now())
+	return b.tokens
+}
+
+// MaxTokens reports the most tokens accountID's bucket can ever bank, i.e.
+// its current weight's cap. A request larger than this can never succeed
+// through Acquire no matter how long it waits, so callers with work that
+// might exceed a single account's cap (e.g. one oversized task) should check
+// this and bypass throttling for that request rather than retry forever.
+func (s *TenantIOScheduler) MaxTokens(accountID uint32) float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.bucketFor(accountID).weight * s.capPerWeight
+}
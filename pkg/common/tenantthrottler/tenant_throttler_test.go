@@ -0,0 +1,84 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenantthrottler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantIOSchedulerBasic(t *testing.T) {
+	s := NewTenantIOScheduler(100, 1000)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+
+	require.True(t, s.Acquire(1, 500))
+	require.False(t, s.Acquire(1, 600))
+	require.InDelta(t, 500, s.Available(1), 0.001)
+}
+
+func TestTenantIOSchedulerRefill(t *testing.T) {
+	s := NewTenantIOScheduler(100, 1000)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+
+	require.True(t, s.Acquire(1, 1000))
+	require.False(t, s.Acquire(1, 1))
+
+	now = now.Add(time.Second)
+	require.True(t, s.Acquire(1, 100))
+	require.False(t, s.Acquire(1, 1))
+}
+
+func TestTenantIOSchedulerWeight(t *testing.T) {
+	s := NewTenantIOScheduler(100, 1000)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+
+	s.SetWeight(1, 1.0)
+	s.SetWeight(2, 2.0)
+	// drain both accounts down to zero
+	require.True(t, s.Acquire(1, 1000))
+	require.True(t, s.Acquire(2, 2000))
+
+	now = now.Add(time.Second)
+	require.InDelta(t, 100, s.Available(1), 0.001)
+	require.InDelta(t, 200, s.Available(2), 0.001)
+}
+
+func TestTenantIOSchedulerWeightBeforeFirstUse(t *testing.T) {
+	s := NewTenantIOScheduler(100, 1000)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+
+	// Set the weight before the bucket is ever touched, so there's no
+	// refill to naturally grow it to the new weight's cap.
+	s.SetWeight(2, 2.0)
+	require.True(t, s.Acquire(2, 2000))
+	require.False(t, s.Acquire(2, 1))
+}
+
+func TestTenantIOSchedulerMaxTokens(t *testing.T) {
+	s := NewTenantIOScheduler(100, 1000)
+	require.InDelta(t, 1000, s.MaxTokens(1), 0.001)
+
+	s.SetWeight(1, 2.0)
+	require.InDelta(t, 2000, s.MaxTokens(1), 0.001)
+
+	// A request above MaxTokens can never succeed, no matter how long it waits.
+	require.False(t, s.Acquire(1, 2001))
+}
@@ -65,6 +65,41 @@ func Test_Modify(t *testing.T) {
 	}
 }
 
+func TestMergePatch(t *testing.T) {
+	type args struct {
+		target   string
+		patch    string
+		expected string
+	}
+	tests := []args{
+		// scalar patch replaces the target wholesale
+		{target: `{"a":1}`, patch: `1`, expected: `1`},
+		// object members are merged recursively
+		{target: `{"a":"b","c":{"d":"e","f":"g"}}`, patch: `{"a":"z","c":{"f":null}}`, expected: `{"a":"z","c":{"d":"e"}}`},
+		// a null member in the patch removes the member from the target
+		{target: `{"a":1,"b":2}`, patch: `{"a":null}`, expected: `{"b":2}`},
+		// a new member in the patch is added
+		{target: `{"a":1}`, patch: `{"b":2}`, expected: `{"a":1,"b":2}`},
+		// merging into a non-object target starts from an empty object
+		{target: `[1,2]`, patch: `{"a":1}`, expected: `{"a":1}`},
+		// an array-valued member is replaced, not merged element-wise
+		{target: `{"a":[1,2,3]}`, patch: `{"a":[4,5]}`, expected: `{"a":[4,5]}`},
+	}
+
+	for _, test := range tests {
+		target, err := ParseFromString(test.target)
+		require.NoError(t, err)
+		patch, err := ParseFromString(test.patch)
+		require.NoError(t, err)
+		expected, err := ParseFromString(test.expected)
+		require.NoError(t, err)
+
+		out, err := target.MergePatch(patch)
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), out.String())
+	}
+}
+
 func TestAppendBinaryJSON(t *testing.T) {
 	tests := []struct {
 		name     string
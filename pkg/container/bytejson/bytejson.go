@@ -516,6 +516,57 @@ func (bj ByteJson) Modify(pathList []*Path, valList []ByteJson, modifyType JsonM
 	return bj, nil
 }
 
+// MergePatch merges patch into bj following RFC 7396 (the semantics MySQL
+// uses for JSON_MERGE_PATCH): an object member in patch that is JSON null
+// removes the corresponding member from the result, an object member present
+// in both is merged recursively, and any non-object patch replaces bj
+// wholesale.
+func (bj ByteJson) MergePatch(patch ByteJson) (ByteJson, error) {
+	if patch.Type != TpCodeObject {
+		return patch, nil
+	}
+
+	vals := make(map[string]ByteJson)
+	if bj.Type == TpCodeObject {
+		cnt := bj.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			vals[string(bj.getObjectKey(i))] = bj.getObjectVal(i)
+		}
+	}
+
+	cnt := patch.GetElemCnt()
+	for i := 0; i < cnt; i++ {
+		key := string(patch.getObjectKey(i))
+		patchVal := patch.getObjectVal(i)
+		if patchVal.IsNull() {
+			delete(vals, key)
+			continue
+		}
+		if old, ok := vals[key]; ok {
+			merged, err := old.MergePatch(patchVal)
+			if err != nil {
+				return Null, err
+			}
+			vals[key] = merged
+		} else {
+			vals[key] = patchVal
+		}
+	}
+
+	keys := make([]string, 0, len(vals))
+	for key := range vals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	keyBytes := make([][]byte, len(keys))
+	elems := make([]ByteJson, len(keys))
+	for i, key := range keys {
+		keyBytes[i] = []byte(key)
+		elems[i] = vals[key]
+	}
+	return buildJsonObject(keyBytes, elems)
+}
+
 func (bj ByteJson) canUnnest() bool {
 	return bj.Type == TpCodeArray || bj.Type == TpCodeObject
 }
@@ -72,3 +72,8 @@ func (r *receiver) pushNextIndex(index uint32) {
 	r.elements[r.tail] = index
 	r.tail = (r.tail + 1) & r.andBase
 }
+
+// depth returns how many elements are pushed but not yet popped.
+func (r *receiver) depth() int {
+	return (r.tail - r.head) & r.andBase
+}
@@ -88,6 +88,15 @@ func (ps *PipelineSpool) SendBatch(
 	return false, nil
 }
 
+// QueueDepth returns how many batches are queued for the idx-th receiver but
+// not yet consumed. It is a heuristic read of the receiver's own head/tail
+// from the sender side, same as the unsynchronized push/pop pair it's built
+// on top of; callers use it to pick a less-behind receiver, not for an exact
+// count.
+func (ps *PipelineSpool) QueueDepth(idx int) int {
+	return ps.rs[idx].depth()
+}
+
 // ReleaseCurrent force to release the last received one.
 func (ps *PipelineSpool) ReleaseCurrent(idx int) {
 	if last, hasLast := ps.rs[idx].getLastPop(); hasLast {
@@ -65,7 +65,14 @@ type syncer struct {
 	syncedLsn atomic.Uint64
 }
 
-// NewDataSync creates a new syncer instance.
+// NewDataSync builds a syncer that mirrors TN WAL entries (logShardID) to a
+// second, upstream-tracking log shard (upstreamLogShardID) that a standby
+// side tails: producer ships the entries, consumer applies them and
+// replicates checkpoint/object files onto the standby for a cold/warm
+// failover promotion, and truncation reclaims log records once synced.
+// writeLsn/syncedLsn track how far the standby has caught up. Nothing in
+// this tree constructs a syncer yet, so it isn't wired into any service's
+// startup path.
 func NewDataSync(
 	sid string,
 	stopper *stopper.Stopper,
@@ -0,0 +1,74 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// FencedFileService wraps a FileService and rejects writes whose
+// IOVector.FenceToken is older than the newest token it has already
+// accepted. It is meant to sit in front of the write path used for
+// appendable objects on shared storage, so that a TN replica that lost
+// its lease during a failover cannot keep writing after a successor has
+// taken over: the successor simply writes with a higher FenceToken (its
+// ReplicaID) and every subsequent write from the old holder is fenced
+// off with moerr.ErrReplicaNotMatch.
+//
+// Writes with a zero FenceToken are never fenced, so callers that don't
+// participate in leasing (most of them) are unaffected.
+type FencedFileService struct {
+	FileService
+	token atomic.Uint64
+}
+
+// NewFencedFileService wraps fs with fencing enforcement.
+func NewFencedFileService(fs FileService) *FencedFileService {
+	return &FencedFileService{
+		FileService: fs,
+	}
+}
+
+// CurrentFenceToken returns the newest accepted fence token.
+func (f *FencedFileService) CurrentFenceToken() uint64 {
+	return f.token.Load()
+}
+
+func (f *FencedFileService) Write(ctx context.Context, vector IOVector) error {
+	if vector.FenceToken != 0 {
+		for {
+			cur := f.token.Load()
+			if vector.FenceToken < cur {
+				return moerr.NewReplicaNotMatch(
+					strconv.FormatUint(cur, 10),
+					strconv.FormatUint(vector.FenceToken, 10),
+				)
+			}
+			if vector.FenceToken == cur {
+				break
+			}
+			// a newer lease holder is writing for the first time: adopt its
+			// token so that the previous holder is fenced off from now on.
+			if f.token.CompareAndSwap(cur, vector.FenceToken) {
+				break
+			}
+		}
+	}
+	return f.FileService.Write(ctx, vector)
+}
@@ -97,6 +97,13 @@ type IOVector struct {
 
 	// Caches indicates extra caches to operate on
 	Caches []IOVectorCache
+
+	// FenceToken is an optional monotonically increasing token identifying the
+	// writer's lease epoch (for example a TN replica ID). When a FencedFileService
+	// is in use, a Write carrying a FenceToken lower than the last accepted token
+	// is rejected, preventing a stale writer (e.g. a TN that lost its lease after
+	// failover) from corrupting shared storage. Zero means "unfenced".
+	FenceToken uint64
 }
 
 type IOEntry struct {
@@ -159,13 +159,22 @@ func (r *RemoteCache) Close(ctx context.Context) {
 	_ = r.client.Close()
 }
 
+// maxRemoteCacheServeBytes caps how many bytes of cached data a single peer
+// cache request may pull out of this node. Without it, one CN holding a
+// popular block could be asked to hand out an unbounded amount of data to
+// peers in one RPC, competing with its own query workload for cache and
+// network bandwidth. Keys beyond the cap are reported as misses so the
+// requester falls back to reading them from local cache or S3 instead.
+const maxRemoteCacheServeBytes = 64 * 1024 * 1024
+
 func HandleRemoteRead(
 	ctx context.Context, fs FileService, req *query.Request, resp *query.WrappedResponse,
 ) error {
 	if req.GetCacheDataRequest == nil {
 		return moerr.NewInternalError(ctx, "bad request")
 	}
-	first := req.GetCacheDataRequest.RequestCacheKey[0].CacheKey
+	keys := req.GetCacheDataRequest.RequestCacheKey
+	first := keys[0].CacheKey
 	if first == nil { // We cannot get the first one.
 		return nil
 	}
@@ -173,16 +182,38 @@ func HandleRemoteRead(
 	ioVec := &IOVector{
 		FilePath: first.Path,
 	}
-	ioVec.Entries = make([]IOEntry, len(req.GetCacheDataRequest.RequestCacheKey))
-	for i, k := range req.GetCacheDataRequest.RequestCacheKey {
-		ioVec.Entries[i].Offset = k.CacheKey.Offset
-		ioVec.Entries[i].Size = k.CacheKey.Sz
+
+	var served int64
+	numServed := len(keys)
+	for i, k := range keys {
+		if k.CacheKey.Sz > 0 {
+			served += k.CacheKey.Sz
+		}
+		if served > maxRemoteCacheServeBytes {
+			numServed = i
+			break
+		}
 	}
-	if err := fs.ReadCache(ctx, ioVec); err != nil {
-		return err
+
+	ioVec.Entries = make([]IOEntry, numServed)
+	for i := 0; i < numServed; i++ {
+		ioVec.Entries[i].Offset = keys[i].CacheKey.Offset
+		ioVec.Entries[i].Size = keys[i].CacheKey.Sz
 	}
-	respData := make([]*query.ResponseCacheData, len(req.GetCacheDataRequest.RequestCacheKey))
-	for i, k := range req.GetCacheDataRequest.RequestCacheKey {
+	if numServed > 0 {
+		if err := fs.ReadCache(ctx, ioVec); err != nil {
+			return err
+		}
+	}
+	respData := make([]*query.ResponseCacheData, len(keys))
+	for i, k := range keys {
+		if i >= numServed {
+			respData[i] = &query.ResponseCacheData{
+				Index: k.Index,
+				Hit:   false,
+			}
+			continue
+		}
 		var data []byte
 		if ioVec.Entries[i].CachedData != nil {
 			data = ioVec.Entries[i].CachedData.Bytes()
@@ -97,6 +97,63 @@ func TestRemoteCache(t *testing.T) {
 	})
 }
 
+func TestHandleRemoteReadServeLimit(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cacheCfg := CacheConfig{
+		MemoryCapacity: ptrTo[toml.ByteSize](1 << 30),
+	}
+	cacheCfg.setDefaults()
+	fs, err := NewLocalFS(ctx, "local-serve-limit", dir, cacheCfg, nil)
+	assert.NoError(t, err)
+	defer fs.Close(ctx)
+
+	err = fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 0, Size: 2, Data: []byte{1, 2}},
+		},
+	})
+	assert.NoError(t, err)
+	warmVec := &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 0, Size: 2, ToCacheData: CacheOriginalData},
+		},
+	}
+	assert.NoError(t, fs.Read(ctx, warmVec))
+	warmVec.Release()
+
+	// one key fits under the cap, the other pushes the running total over it,
+	// so it should come back as a miss without ever being read from cache.
+	req := &query.Request{
+		GetCacheDataRequest: &query.GetCacheDataRequest{
+			RequestCacheKey: []*query.RequestCacheKey{
+				{
+					Index: 0,
+					CacheKey: &query.CacheKey{
+						Path: "foo", Offset: 0, Sz: 2,
+					},
+				},
+				{
+					Index: 1,
+					CacheKey: &query.CacheKey{
+						Path: "foo", Offset: 0, Sz: maxRemoteCacheServeBytes + 1,
+					},
+				},
+			},
+		},
+	}
+	resp := &query.WrappedResponse{Response: &query.Response{}}
+	err = HandleRemoteRead(ctx, fs, req, resp)
+	assert.NoError(t, err)
+	defer resp.ReleaseFunc()
+
+	data := resp.GetCacheDataResponse.ResponseCacheData
+	assert.Equal(t, 2, len(data))
+	assert.Equal(t, false, data[1].Hit)
+}
+
 func runTestWithTwoFileServices(t *testing.T, fn func(sf1 *cacheFs, sf2 *cacheFs)) {
 	defer leaktest.AfterTest(t)()
 
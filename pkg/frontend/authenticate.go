@@ -1029,9 +1029,13 @@ var (
 		MoCatalogMoISCPLogDDL,
 		MoCatalogMoIndexUpdateDDL,
 		MoCatalogBranchMetadataDDL,
+		MoCatalogMoBranchOrphansDDL,
+		MoCatalogMoBranchStatusDDL,
 		MoCatalogFeatureLimitDDL,
 		MoCatalogFeatureRegistryDDL,
 		MoCatalogFeatureRegistryInitData,
+		MoCatalogMoFeatureUsageDDL,
+		MoCatalogMoLifecycleEventsDDL,
 	}
 
 	// drop tables for the tenant
@@ -1050,6 +1054,10 @@ var (
 		`drop view if exists mo_catalog.mo_variables;`,
 		`drop view if exists mo_catalog.mo_transactions;`,
 		`drop view if exists mo_catalog.mo_cache;`,
+		`drop view if exists mo_catalog.mo_branch_orphans;`,
+		`drop view if exists mo_catalog.mo_branch_status;`,
+		`drop view if exists mo_catalog.mo_feature_usage;`,
+		`drop table if exists mo_catalog.mo_lifecycle_events;`,
 		`drop table if exists mo_catalog.mo_snapshots;`,
 	}
 	dropMoMysqlCompatibilityModeSql = `drop table if exists mo_catalog.mo_mysql_compatibility_mode;`
@@ -5976,6 +5984,10 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		objType = objectTypeNone
 		kind = privilegeKindSpecial
 		special = specialTagAdmin
+	case *tree.VerifyBackup:
+		objType = objectTypeNone
+		kind = privilegeKindSpecial
+		special = specialTagAdmin
 	case *tree.EmptyStmt:
 		objType = objectTypeNone
 		kind = privilegeKindNone
@@ -5987,7 +5999,8 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		*tree.DataBranchCreateTable,
 		*tree.DataBranchDeleteTable,
 		*tree.DataBranchMerge,
-		*tree.DataBranchDiff:
+		*tree.DataBranchDiff,
+		*tree.DataBranchExchange:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
 		writeDatabaseAndTableDirectly = true
@@ -7018,7 +7031,8 @@ func authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ctx con
 		case *tree.CloneTable, *tree.CloneDatabase,
 			*tree.DataBranchDiff, *tree.DataBranchMerge,
 			*tree.DataBranchCreateTable, *tree.DataBranchCreateDatabase,
-			*tree.DataBranchDeleteTable, *tree.DataBranchDeleteDatabase:
+			*tree.DataBranchDeleteTable, *tree.DataBranchDeleteDatabase,
+			*tree.DataBranchExchange:
 			return true, stats, nil
 		}
 	}
@@ -7736,7 +7750,7 @@ func authenticateUserCanExecuteStatementWithObjectTypeNone(ctx context.Context,
 			return yes, stats, err
 		case *tree.UpgradeStatement:
 			return tenant.IsMoAdminRole(), stats, nil
-		case *tree.BackupStart:
+		case *tree.BackupStart, *tree.VerifyBackup:
 			yes, err := checkBackUpStartPrivilege()
 			return yes, stats, err
 		case *tree.CreateCDC, *tree.ShowCDC, *tree.PauseCDC, *tree.DropCDC, *tree.ResumeCDC, *tree.RestartCDC:
@@ -11080,6 +11080,72 @@ func TestDoCreateSnapshot(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 
+	// a timestamp hint on anything but a table-level snapshot is rejected
+	convey.Convey("doCreateSnapshot fail, at-timestamp only allowed for table level", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newTestSession(t, ctrl)
+		defer ses.Close()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		pu.SV.KillRountinesInterval = 0
+		setPu("", pu)
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		rm, _ := NewRoutineManager(ctx, "")
+		ses.rm = rm
+
+		tenant := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      sysAccountID,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
+		}
+		ses.SetTenantInfo(tenant)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		timeStamp, _ := timestamp.ParseTimestamp("2021-01-01 00:00:00")
+		txnOperator.EXPECT().SnapshotTS().Return(timeStamp).AnyTimes()
+		// process.
+		ses.proc = testutil.NewProc(t)
+		ses.proc.Base.TxnOperator = txnOperator
+		cs := &tree.CreateSnapShot{
+			IfNotExists: false,
+			Name:        tree.Identifier("snapshot_test"),
+			Object: tree.ObjectInfo{
+				SLevel: tree.SnapshotLevelType{
+					Level: tree.SNAPSHOTLEVELCLUSTER,
+				},
+				ObjName: "",
+				AtTsExpr: &tree.AtTimeStamp{
+					Type: tree.ATMOTIMESTAMP,
+					Expr: tree.NewNumVal(int64(123456), "123456", false, tree.P_int64),
+				},
+			},
+		}
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
+		mrs := newMrsForPasswordOfUser([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		err := doCreateSnapshot(ctx, ses, cs)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
 	// non-system tenant can't create cluster level snapshot
 	convey.Convey("doCreateSnapshot fail", t, func() {
 		ctrl := gomock.NewController(t)
@@ -16,8 +16,11 @@ package frontend
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/backup"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -41,3 +44,27 @@ func doBackup(ctx context.Context, ses FeSession, bs *tree.BackupStart) error {
 	}
 	return backup.Backup(ctx, ses.GetService(), bs, conf)
 }
+
+func handleVerifyBackup(ses *Session, execCtx *ExecCtx, vb *tree.VerifyBackup) error {
+	result, err := doVerifyBackup(execCtx.reqCtx, ses, vb)
+	if err != nil {
+		return err
+	}
+	if !result.OK() {
+		lines := make([]string, 0, len(result.Mismatches))
+		for _, m := range result.Mismatches {
+			lines = append(lines, fmt.Sprintf("%s: %s", m.Path, m.Reason))
+		}
+		return moerr.NewInternalError(execCtx.reqCtx, fmt.Sprintf(
+			"backup at %s failed verification: %d/%d files mismatched: %s",
+			vb.Dir, len(result.Mismatches), result.FilesChecked, strings.Join(lines, "; ")))
+	}
+	return nil
+}
+
+// doVerifyBackup re-reads the backup at vb.Dir and cross-checks every tae
+// file it holds against the manifest (tae_list) the backup itself
+// recorded when it was taken.
+func doVerifyBackup(ctx context.Context, ses FeSession, vb *tree.VerifyBackup) (*backup.VerifyResult, error) {
+	return backup.VerifyBackup(ctx, vb.Dir)
+}
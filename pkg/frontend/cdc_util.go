@@ -324,6 +324,71 @@ var initAesKeyBySqlExecutor = func(
 	return
 }
 
+// GetAccountDataKey resolves accountId's own data key, provisioning one via
+// cdc.GetAccountDataKeySql on first use. Unlike initAesKeyBySqlExecutor,
+// which resolves and caches a single process-wide key for the CDC subsystem
+// under cdc.AesKey, this always queries accountId's own mo_data_key row, so
+// callers that need per-tenant key separation (e.g. object encryption-at-rest)
+// don't collapse onto the system account's key the way CDC currently does.
+//
+// Not yet wired into the CDC sink-password path itself (ToInsertTaskSQL's
+// initAesKeyBySqlExecutor calls still pass catalog.System_Account): cdc.AesKey
+// is a single process-wide var, and cdc.UriInfo.GetEncodedPassword /
+// AesCFBEncode / AesCFBDecode all read it globally rather than taking a key
+// parameter, so making CDC itself per-account would mean threading an
+// account-specific key through every one of those call sites, not just
+// swapping which account's key gets resolved here. That's a separate,
+// larger change than this function.
+var GetAccountDataKey = func(
+	ctx context.Context,
+	executor taskservice.SqlExecutor,
+	accountId uint32,
+	service string,
+) (string, error) {
+	kek := []byte(getGlobalPuWrapper(service).SV.KeyEncryptionKey)
+
+	encryptedKey, err := queryAccountDataKey(ctx, executor, accountId)
+	if err != nil {
+		return "", err
+	}
+	if len(encryptedKey) == 0 {
+		provisionSql, err := cdc.GetAccountDataKeySql(accountId, string(kek))
+		if err != nil {
+			return "", err
+		}
+		if _, err = executor.ExecContext(ctx, provisionSql); err != nil {
+			return "", err
+		}
+		if encryptedKey, err = queryAccountDataKey(ctx, executor, accountId); err != nil {
+			return "", err
+		}
+		if len(encryptedKey) == 0 {
+			return "", moerr.NewInternalError(ctx, "no data key")
+		}
+	}
+
+	return cdc.AesCFBDecodeWithKey(ctx, encryptedKey, kek)
+}
+
+func queryAccountDataKey(ctx context.Context, executor taskservice.SqlExecutor, accountId uint32) (encryptedKey string, err error) {
+	querySql := cdc.CDCSQLBuilder.GetDataKeySQL(uint64(accountId), cdc.InitKeyId)
+	_, err = ForeachQueriedRow(
+		ctx,
+		executor,
+		querySql,
+		func(ctx context.Context, rows *sql.Rows) (bool, error) {
+			if len(encryptedKey) > 0 {
+				return false, nil
+			}
+			if err2 := rows.Scan(&encryptedKey); err2 != nil {
+				return false, err2
+			}
+			return true, nil
+		},
+	)
+	return
+}
+
 func CDCStrToTime(tsStr string, tz *time.Location) (ts time.Time, err error) {
 	if tsStr == "" {
 		return
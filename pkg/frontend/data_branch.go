@@ -466,6 +466,8 @@ func handleDataBranch(
 		return handleBranchDiff(execCtx, ses, st)
 	case *tree.DataBranchMerge:
 		return handleBranchMerge(execCtx, ses, st)
+	case *tree.DataBranchExchange:
+		return dataBranchExchangeTable(execCtx, ses, st)
 	default:
 		return moerr.NewNotSupportedNoCtxf("data branch not supported: %v", st)
 	}
@@ -770,6 +772,104 @@ func dataBranchDeleteDatabase(
 	return nil
 }
 
+// dataBranchExchangeTable implements `data branch exchange table t1 with t2`:
+// t1 and t2 trade names within a single catalog transaction, going through
+// the ordinary (metadata-only) rename path three times -- t1 to a throwaway
+// name, t2 to t1, then the throwaway name to t2 -- so the swap is as atomic
+// as any other multi-statement DDL run on the same BackgroundExec. This is
+// how a fully validated branch gets promoted to be the main table: after
+// the swap t1 is whatever t2 used to be, and the displaced table, now named
+// t2, gets a fresh mo_branch_metadata row recording it as a branch of the
+// new t1, so it's still there to roll back to.
+func dataBranchExchangeTable(
+	execCtx *ExecCtx,
+	ses *Session,
+	stmt *tree.DataBranchExchange,
+) (err error) {
+	var (
+		bh       BackgroundExec
+		deferred func(error) error
+	)
+
+	if bh, deferred, err = getBackExecutor(execCtx.reqCtx, ses); err != nil {
+		return
+	}
+
+	defer func() {
+		if deferred != nil {
+			err = deferred(err)
+		}
+	}()
+
+	var (
+		dbName1  = stmt.TableName1.SchemaName
+		tblName1 = stmt.TableName1.ObjectName
+		dbName2  = stmt.TableName2.SchemaName
+		tblName2 = stmt.TableName2.ObjectName
+		accId    uint32
+	)
+
+	if len(dbName1) == 0 {
+		dbName1 = tree.Identifier(ses.GetTxnCompileCtx().DefaultDatabase())
+	}
+	if len(dbName2) == 0 {
+		dbName2 = tree.Identifier(ses.GetTxnCompileCtx().DefaultDatabase())
+	}
+	if dbName1 != dbName2 {
+		return moerr.NewNotSupportedNoCtxf("branch exchange across databases: %s, %s", dbName1, dbName2)
+	}
+
+	if accId, err = defines.GetAccountId(execCtx.reqCtx); err != nil {
+		return
+	}
+
+	var tblDef1, tblDef2 *plan.TableDef
+	if _, tblDef1, err = ses.GetTxnCompileCtx().Resolve(string(dbName1), string(tblName1), nil); err != nil {
+		return
+	}
+	if _, tblDef2, err = ses.GetTxnCompileCtx().Resolve(string(dbName2), string(tblName2), nil); err != nil {
+		return
+	}
+	if !isSchemaEquivalent(tblDef1, tblDef2) {
+		return moerr.NewInternalErrorNoCtxf(
+			"branch exchange requires the same schema: %s.%s, %s.%s",
+			dbName1, tblName1, dbName2, tblName2,
+		)
+	}
+
+	tmpName := fmt.Sprintf("__branch_exchange_%d__", tblDef1.TblId)
+	renameSqls := []string{
+		fmt.Sprintf("alter table `%s`.`%s` rename to `%s`", dbName1, tblName1, tmpName),
+		fmt.Sprintf("alter table `%s`.`%s` rename to `%s`", dbName2, tblName2, tblName1),
+		fmt.Sprintf("alter table `%s`.`%s` rename to `%s`", dbName1, tmpName, tblName2),
+	}
+	for _, sql := range renameSqls {
+		var renameRet executor.Result
+		if renameRet, err = runSql(execCtx.reqCtx, ses, bh, sql, nil, nil); err != nil {
+			return
+		}
+		renameRet.Close()
+	}
+
+	insertMetaSql := fmt.Sprintf(
+		insertIntoBranchMetadataSql,
+		catalog.MO_CATALOG,
+		catalog.MO_BRANCH_METADATA,
+		tblDef1.TblId,
+		time.Now().UnixNano(),
+		tblDef2.TblId,
+		accId,
+		dataBranchLevel_Table,
+	)
+	var insertRet executor.Result
+	if insertRet, err = runSql(execCtx.reqCtx, ses, bh, insertMetaSql, nil, nil); err != nil {
+		return
+	}
+	insertRet.Close()
+
+	return nil
+}
+
 func diffMergeAgency(
 	ses *Session,
 	execCtx *ExecCtx,
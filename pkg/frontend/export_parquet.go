@@ -182,6 +182,10 @@ func vectorValueToParquet(vec *vector.Vector, i int, timeZone *time.Location) (a
 	case types.T_json:
 		val := types.DecodeJson(vec.GetBytesAt(i))
 		return val.String(), nil
+	case types.T_array_float32:
+		return types.BytesToArrayToString[float32](vec.GetBytesAt(i)), nil
+	case types.T_array_float64:
+		return types.BytesToArrayToString[float64](vec.GetBytesAt(i)), nil
 	case types.T_date:
 		val := vector.GetFixedAtNoTypeCheck[types.Date](vec, i)
 		return val.String(), nil
@@ -31,12 +31,14 @@ import (
 const (
 	featureCodeSnapshot = "SNAPSHOT"
 	featureCodeBranch   = "BRANCH"
+	featureCodeTable    = "TABLE"
 )
 
 const (
 	defaultFeatureLimitForSys = -1
 	defaultBranchLimit        = 50
 	defaultSnapshotLimit      = 50
+	defaultTableLimit         = 5000
 )
 
 type moFeatureScopeSpec struct {
@@ -62,6 +64,36 @@ func checkBranchQuota(
 	return featureLimitChecker(ctx, ses, bh, featureCodeBranch, "", increment)
 }
 
+func checkTableQuota(
+	ctx context.Context,
+	ses *Session,
+	bh BackgroundExec,
+	increment int64,
+) (err error) {
+	return featureLimitChecker(ctx, ses, bh, featureCodeTable, "", increment)
+}
+
+// checkCreateTableQuota is the CREATE TABLE entry point: it opens its own
+// background executor, since the table-count quota must be checked before
+// the statement's own plan runs and creates the table.
+func checkCreateTableQuota(ctx context.Context, ses *Session) (err error) {
+	var (
+		bh       BackgroundExec
+		deferred func(error) error
+	)
+
+	if bh, deferred, err = getBackExecutor(ctx, ses); err != nil {
+		return err
+	}
+	defer func() {
+		if deferred != nil {
+			err = deferred(err)
+		}
+	}()
+
+	return checkTableQuota(ctx, ses, bh, 1)
+}
+
 func featureLimitChecker(
 	ctx context.Context,
 	ses *Session,
@@ -115,6 +147,11 @@ func featureLimitChecker(
 			"select count(*) from %s.%s where creator = %d and table_deleted = false",
 			catalog.MO_CATALOG, catalog.MO_BRANCH_METADATA, accId,
 		)
+	} else if featureCode == featureCodeTable {
+		sql = fmt.Sprintf(
+			"select count(*) from %s.%s where account_id = %d and relkind = '%s'",
+			catalog.MO_CATALOG, catalog.MO_TABLES, accId, catalog.SystemOrdinaryRel,
+		)
 	} else {
 		return moerr.NewInternalErrorNoCtxf("no such feature %s with scope %s", featureCode, featureScope)
 	}
@@ -189,9 +226,12 @@ func queryQuota(
 
 	if len(sqlRet.Batches) == 0 || sqlRet.Batches[0].RowCount() == 0 {
 		// no record for this account, init
-		if code == featureCodeSnapshot {
+		switch code {
+		case featureCodeSnapshot:
 			quota = defaultSnapshotLimit
-		} else {
+		case featureCodeTable:
+			quota = defaultTableLimit
+		default:
 			quota = defaultBranchLimit
 		}
 
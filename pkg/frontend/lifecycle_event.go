@@ -0,0 +1,74 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+)
+
+// lifecycleEventKind identifies the kind of catalog lifecycle event recorded
+// into mo_catalog.mo_lifecycle_events (see MoCatalogMoLifecycleEventsDDL).
+type lifecycleEventKind string
+
+const (
+	lifecycleEventSnapshotCreated lifecycleEventKind = "snapshot_created"
+	lifecycleEventSnapshotDropped lifecycleEventKind = "snapshot_dropped"
+	lifecycleEventRestoreExecuted lifecycleEventKind = "restore_executed"
+)
+
+func lifecycleEscapeSQLString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+// recordLifecycleEvent appends a row to mo_catalog.mo_lifecycle_events. It is
+// called from within an already-open bh transaction (e.g. doCreateSnapshot's
+// begin/commit), so a failure here rolls back with the rest of the
+// statement rather than leaving the event log out of sync with what it
+// describes.
+func recordLifecycleEvent(
+	ctx context.Context,
+	ses *Session,
+	bh BackgroundExec,
+	kind lifecycleEventKind,
+	dbName string,
+	tableName string,
+	detail string,
+	ts int64,
+) error {
+	tenantInfo := ses.GetTenantInfo()
+	actor := tenantInfo.GetTenant()
+	if user := tenantInfo.GetUser(); user != "" {
+		actor = fmt.Sprintf("%s:%s", actor, user)
+	}
+
+	sql := fmt.Sprintf(
+		`insert into %s.%s(account_id, event_kind, db_name, table_name, detail, actor, stmt, event_ts) values (%d, '%s', '%s', '%s', '%s', '%s', '%s', %d);`,
+		catalog.MO_CATALOG, catalog.MO_LIFECYCLE_EVENTS,
+		tenantInfo.GetTenantID(),
+		kind,
+		lifecycleEscapeSQLString(dbName),
+		lifecycleEscapeSQLString(tableName),
+		lifecycleEscapeSQLString(detail),
+		lifecycleEscapeSQLString(actor),
+		lifecycleEscapeSQLString(ses.GetSql()),
+		ts,
+	)
+
+	return bh.Exec(ctx, sql)
+}
@@ -136,7 +136,9 @@ var (
 			database_name varchar(5000),
 			table_name  varchar(5000),
 			obj_id bigint unsigned,
-    		kind varchar(32) not null default 'user'
+    		kind varchar(32) not null default 'user',
+    		created_by varchar(300) not null default '',
+    		comment varchar(5000) not null default ''
 			)`, catalog.MO_CATALOG, catalog.MO_SNAPSHOTS)
 
 	MoCatalogMoPitrDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
@@ -352,11 +354,41 @@ var (
     	creator bigint unsigned not null comment 'account id of the creator',
     	level varchar not null,
     	table_deleted bool not null default false,
+    	last_read_ts bigint signed not null default 0 comment 'last observed read timestamp in nanoseconds, 0 if never recorded',
+    	last_write_ts bigint signed not null default 0 comment 'last observed write timestamp in nanoseconds, 0 if never recorded',
+    	read_count bigint unsigned not null default 0 comment 'reads recorded via mo_ctl branch-touch',
+    	write_count bigint unsigned not null default 0 comment 'writes recorded via mo_ctl branch-touch',
     	index(p_table_id),
     	index(creator),
     	primary key(table_id)
 	)`, catalog.MO_BRANCH_METADATA)
 
+	// MoCatalogMoBranchOrphansDDL surfaces branch/clone tables whose
+	// mo_branch_metadata bookkeeping has gotten out of sync with mo_tables:
+	// either the base table the branch was cloned from is gone (base_missing),
+	// or the branch table itself is gone but nothing ever marked its metadata
+	// row deleted. Replaces having to hunt these down with ad-hoc queries.
+	MoCatalogMoBranchOrphansDDL = fmt.Sprintf(`CREATE VIEW mo_catalog.mo_branch_orphans AS
+		SELECT bm.table_id, bm.p_table_id, bm.clone_ts, bm.creator, bm.level,
+			(pt.rel_id IS NULL) AS base_missing
+		FROM mo_catalog.%s bm
+		LEFT JOIN mo_catalog.mo_tables t ON bm.table_id = t.rel_id
+		LEFT JOIN mo_catalog.mo_tables pt ON bm.p_table_id = pt.rel_id
+		WHERE bm.table_deleted = false AND (t.rel_id IS NULL OR pt.rel_id IS NULL)`,
+		catalog.MO_BRANCH_METADATA)
+
+	// MoCatalogMoBranchStatusDDL surfaces per-branch access activity --
+	// reads/writes recorded via `mo_ctl('cn', 'branch-touch', ...)` and the
+	// timestamps of the most recent ones -- so stale experiment branches
+	// that nothing has touched in a while can be spotted without hunting
+	// through mo_branch_metadata by hand.
+	MoCatalogMoBranchStatusDDL = fmt.Sprintf(`CREATE VIEW mo_catalog.mo_branch_status AS
+		SELECT table_id, p_table_id, creator, level, clone_ts,
+			last_read_ts, last_write_ts, read_count, write_count
+		FROM mo_catalog.%s
+		WHERE table_deleted = false`,
+		catalog.MO_BRANCH_METADATA)
+
 	MoCatalogFeatureLimitDDL = fmt.Sprintf(`create table mo_catalog.%s(
     	account_id bigint unsigned not null comment 'this limit applies on this account',
     	feature_code varchar(50) NOT NULL comment 'snapshot/branch/...',
@@ -377,10 +409,67 @@ var (
     	primary key(feature_code)
 	)`, catalog.MO_FEATURE_REGISTRY)
 
-	MoCatalogFeatureRegistryInitData = fmt.Sprintf(`insert into mo_catalog.%s(feature_code, scope_spec) values 
+	MoCatalogFeatureRegistryInitData = fmt.Sprintf(`insert into mo_catalog.%s(feature_code, scope_spec) values
 		('SNAPSHOT', '{"allowed_scope":["account","database","table"]}'),
-		('BRANCH', '{"allowed_scope":[]}')
+		('BRANCH', '{"allowed_scope":[]}'),
+		('TABLE', '{"allowed_scope":[]}')
 		on duplicate key update scope_spec = values(scope_spec);`, catalog.MO_FEATURE_REGISTRY)
+
+	// MoCatalogMoFeatureUsageDDL surfaces each account's usage against its
+	// configured quota for every feature mo_feature_limit tracks, so an
+	// approaching or exceeded limit can be spotted without separately
+	// counting rows in mo_tables/mo_branch_metadata/mo_snapshots and cross
+	// referencing mo_feature_limit by hand. An account only appears here
+	// once queryQuota has recorded a limit row for it (quota rows are
+	// created lazily, on first check, not at account-creation time).
+	MoCatalogMoFeatureUsageDDL = fmt.Sprintf(`CREATE VIEW mo_catalog.mo_feature_usage AS
+		SELECT l.account_id, a.account_name, l.feature_code, l.scope, l.quota, COALESCE(t.used, 0) AS used
+		FROM mo_catalog.%[1]s l
+		JOIN mo_catalog.mo_account a ON a.account_id = l.account_id
+		LEFT JOIN (
+			SELECT account_id, count(*) AS used FROM mo_catalog.%[2]s WHERE relkind = '%[3]s' GROUP BY account_id
+		) t ON t.account_id = l.account_id
+		WHERE l.feature_code = 'TABLE'
+		UNION ALL
+		SELECT l.account_id, a.account_name, l.feature_code, l.scope, l.quota, COALESCE(b.used, 0) AS used
+		FROM mo_catalog.%[1]s l
+		JOIN mo_catalog.mo_account a ON a.account_id = l.account_id
+		LEFT JOIN (
+			SELECT creator AS account_id, count(*) AS used FROM mo_catalog.%[4]s WHERE table_deleted = false GROUP BY creator
+		) b ON b.account_id = l.account_id
+		WHERE l.feature_code = 'BRANCH'
+		UNION ALL
+		SELECT l.account_id, a.account_name, l.feature_code, l.scope, l.quota, COALESCE(s.used, 0) AS used
+		FROM mo_catalog.%[1]s l
+		JOIN mo_catalog.mo_account a ON a.account_id = l.account_id
+		LEFT JOIN (
+			SELECT a2.account_id AS account_id, sn.level AS scope, count(*) AS used
+			FROM mo_catalog.%[5]s sn
+			JOIN mo_catalog.mo_account a2 ON a2.account_name = sn.account_name
+			GROUP BY a2.account_id, sn.level
+		) s ON s.account_id = l.account_id AND s.scope = l.scope
+		WHERE l.feature_code = 'SNAPSHOT'`,
+		catalog.MO_FEATURE_LIMIT, catalog.MO_TABLES, catalog.SystemOrdinaryRel,
+		catalog.MO_BRANCH_METADATA, catalog.MO_SNAPSHOTS)
+
+	// MoCatalogMoLifecycleEventsDDL is an append-only log of catalog
+	// lifecycle events (snapshot created/dropped, restore executed, ...),
+	// giving tools a reliable event history queryable over SQL instead of
+	// each tool maintaining its own, e.g. the demo's branch_management
+	// inserts. See recordLifecycleEvent in lifecycle_event.go.
+	MoCatalogMoLifecycleEventsDDL = fmt.Sprintf(`create table mo_catalog.%s(
+    	event_id bigint unsigned auto_increment,
+    	account_id bigint unsigned not null comment 'account the event was recorded under',
+    	event_kind varchar(50) not null comment 'snapshot_created/snapshot_dropped/restore_executed/...',
+    	db_name varchar(5000) not null default '',
+    	table_name varchar(5000) not null default '',
+    	detail varchar(5000) not null default '' comment 'e.g. the snapshot name',
+    	actor varchar(300) not null comment 'account/user that issued the statement',
+    	stmt text comment 'the SQL statement that caused this event',
+    	event_ts bigint signed not null comment 'event timestamp in nanoseconds',
+    	index(account_id),
+    	primary key(event_id)
+	)`, catalog.MO_LIFECYCLE_EVENTS)
 )
 
 // `mo_catalog` database system tables
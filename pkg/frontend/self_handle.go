@@ -413,6 +413,12 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (stats statistic.StatsArray,
 		if err = handleStartBackup(ses, execCtx, st); err != nil {
 			return
 		}
+	case *tree.VerifyBackup:
+		ses.EnterFPrint(FPVerifyBackup)
+		defer ses.ExitFPrint(FPVerifyBackup)
+		if err = handleVerifyBackup(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.EmptyStmt:
 
 		if err = handleEmptyStmt(ses, execCtx, st); err != nil {
@@ -557,7 +563,8 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (stats statistic.StatsArray,
 		*tree.DataBranchCreateTable,
 		*tree.DataBranchDeleteTable,
 		*tree.DataBranchDeleteDatabase,
-		*tree.DataBranchCreateDatabase:
+		*tree.DataBranchCreateDatabase,
+		*tree.DataBranchExchange:
 
 		ses.EnterFPrint(FPDataBranch)
 		defer ses.ExitFPrint(FPDataBranch)
@@ -55,7 +55,9 @@ const (
 		account_name,
 		database_name,
 		table_name,
-		obj_id ) values ('%s', '%s', %d, '%s', '%s', '%s', '%s', %d);`
+		obj_id,
+		created_by,
+		comment ) values ('%s', '%s', %d, '%s', '%s', '%s', '%s', %d, '%s', '%s');`
 
 	dropSnapshotFormat = `delete from mo_catalog.mo_snapshots where sname = '%s' order by snapshot_id;`
 
@@ -247,6 +249,20 @@ func doCreateSnapshot(ctx context.Context, ses *Session, stmt *tree.CreateSnapSh
 		return err
 	}
 
+	// a table-level snapshot can pin an explicit {MO_TS = n} instead of now,
+	// so a timestamp found via time-travel exploration can be promoted into a
+	// durable, nameable snapshot.
+	if stmt.Object.AtTsExpr != nil {
+		if snapshotLevel != tree.SNAPSHOTLEVELTABLE {
+			return moerr.NewInternalError(ctx, "only table-level snapshots can specify a timestamp")
+		}
+		var atSnapshot *plan.Snapshot
+		if atSnapshot, err = resolveSnapshot(ses, stmt.Object.AtTsExpr); err != nil {
+			return err
+		}
+		snapshotTS = atSnapshot.TS.PhysicalTime
+	}
+
 	// 3. get database name , table name  and objId according to the snapshot level
 	switch snapshotLevel {
 	case tree.SNAPSHOTLEVELCLUSTER:
@@ -260,6 +276,8 @@ func doCreateSnapshot(ctx context.Context, ses *Session, stmt *tree.CreateSnapSh
 			"",
 			"",
 			math.MaxUint64,
+			currentAccount,
+			plan.EscapeFormat(stmt.Comment),
 		)
 		if err != nil {
 			return err
@@ -321,6 +339,8 @@ func doCreateSnapshot(ctx context.Context, ses *Session, stmt *tree.CreateSnapSh
 			"",
 			"",
 			objId,
+			currentAccount,
+			plan.EscapeFormat(stmt.Comment),
 		)
 		if err != nil {
 			return err
@@ -375,6 +395,8 @@ func doCreateSnapshot(ctx context.Context, ses *Session, stmt *tree.CreateSnapSh
 			databaseName,
 			"",
 			objId,
+			currentAccount,
+			plan.EscapeFormat(stmt.Comment),
 		)
 		if err != nil {
 			return err
@@ -439,6 +461,8 @@ func doCreateSnapshot(ctx context.Context, ses *Session, stmt *tree.CreateSnapSh
 			databaseName,
 			tableName,
 			objId,
+			currentAccount,
+			plan.EscapeFormat(stmt.Comment),
 		)
 		if err != nil {
 			return err
@@ -451,6 +475,13 @@ func doCreateSnapshot(ctx context.Context, ses *Session, stmt *tree.CreateSnapSh
 		return err
 	}
 
+	if err = recordLifecycleEvent(
+		ctx, ses, bh, lifecycleEventSnapshotCreated,
+		databaseName, tableName, snapshotName, snapshotTS,
+	); err != nil {
+		return err
+	}
+
 	getLogger(ses.GetService()).Debug(fmt.Sprintf("create snapshot %s success", snapshotName))
 	return err
 }
@@ -518,6 +549,17 @@ func doDropSnapshot(ctx context.Context, ses *Session, stmt *tree.DropSnapShot)
 		if err != nil {
 			return err
 		}
+
+		dropTS, tsErr := tryToIncreaseTxnPhysicalTS(ctx, ses.proc.GetTxnOperator())
+		if tsErr != nil {
+			return tsErr
+		}
+		if err = recordLifecycleEvent(
+			ctx, ses, bh, lifecycleEventSnapshotDropped,
+			"", "", string(stmt.Name), dropTS,
+		); err != nil {
+			return err
+		}
 	}
 
 	getLogger(ses.GetService()).Debug(fmt.Sprintf("drop snapshot %s success", string(stmt.Name)))
@@ -547,6 +589,20 @@ func doRestoreSnapshot(ctx context.Context, ses *Session, stmt *tree.RestoreSnap
 	defer func() {
 		err = finishTxn(ctx, bh, err)
 	}()
+	defer func() {
+		if err != nil {
+			return
+		}
+		restoreTS, tsErr := tryToIncreaseTxnPhysicalTS(ctx, ses.proc.GetTxnOperator())
+		if tsErr != nil {
+			err = tsErr
+			return
+		}
+		err = recordLifecycleEvent(
+			ctx, ses, bh, lifecycleEventRestoreExecuted,
+			dbName, tblName, snapshotName, restoreTS,
+		)
+	}()
 
 	// check snapshot
 	snapshot, err := getSnapshotByName(ctx, bh, snapshotName)
@@ -674,7 +730,8 @@ func doRestoreSnapshot(ctx context.Context, ses *Session, stmt *tree.RestoreSnap
 			fkTableMap,
 			viewMap,
 			snapshot.ts,
-			restoreAccount); err != nil {
+			restoreAccount,
+			string(stmt.AsTableName)); err != nil {
 			return stats, err
 		}
 	}
@@ -992,7 +1049,8 @@ func restoreToDatabase(
 		snapshotTs,
 		restoreAccount,
 		isRestoreCluster,
-		subDbToRestore)
+		subDbToRestore,
+		"")
 }
 
 func restoreToTable(
@@ -1006,7 +1064,8 @@ func restoreToTable(
 	fkTableMap map[string]*tableInfo,
 	viewMap map[string]*tableInfo,
 	snapshotTs int64,
-	restoreAccount uint32) (err error) {
+	restoreAccount uint32,
+	asTableName string) (err error) {
 	getLogger(sid).Debug(fmt.Sprintf("[%s] start to restore table: %v, restore timestamp: %d", snapshotName, tblName, snapshotTs))
 	return restoreToDatabaseOrTable(ctx,
 		sid,
@@ -1020,7 +1079,8 @@ func restoreToTable(
 		snapshotTs,
 		restoreAccount,
 		false,
-		nil)
+		nil,
+		asTableName)
 }
 
 func restoreToDatabaseOrTable(
@@ -1037,6 +1097,7 @@ func restoreToDatabaseOrTable(
 	restoreAccount uint32,
 	isRestoreCluster bool,
 	subDbToRestore map[string]*subDbRestoreRecord,
+	asTableName string,
 ) (err error) {
 	if needSkipDb(dbName) {
 		getLogger(sid).Debug(fmt.Sprintf("[%s] skip restore db: %v", snapshotName, dbName))
@@ -1164,7 +1225,7 @@ func restoreToDatabaseOrTable(
 			return
 		}
 
-		if err = recreateTable(ctx, sid, bh, snapshotName, tblInfo, toAccountId, snapshotTs); err != nil {
+		if err = recreateTable(ctx, sid, bh, snapshotName, tblInfo, toAccountId, snapshotTs, asTableName); err != nil {
 			return
 		}
 	}
@@ -1216,7 +1277,7 @@ func restoreSystemDatabase(
 			return
 		}
 
-		if err = recreateTable(ctx, sid, bh, snapshotName, tblInfo, toAccountId, snapshotTs); err != nil {
+		if err = recreateTable(ctx, sid, bh, snapshotName, tblInfo, toAccountId, snapshotTs, ""); err != nil {
 			return
 		}
 	}
@@ -1292,7 +1353,7 @@ func restoreTablesWithFk(
 		// e.g. t1.pk <- t2.fk, we only want to restore t2, fkTableMap[t1.key] is nil, ignore t1
 		if tblInfo := fkTableMap[key]; tblInfo != nil {
 			getLogger(sid).Debug(fmt.Sprintf("[%s] start to restore table with fk: %v, restore timestamp: %d", snapshotName, tblInfo.tblName, snapshotTs))
-			if err = recreateTable(ctx, sid, bh, snapshotName, tblInfo, toAccountId, snapshotTs); err != nil {
+			if err = recreateTable(ctx, sid, bh, snapshotName, tblInfo, toAccountId, snapshotTs, ""); err != nil {
 				return
 			}
 		}
@@ -1423,8 +1484,18 @@ func recreateTable(
 	tblInfo *tableInfo,
 	toAccountId uint32,
 	snapshotTs int64,
+	asTableName string,
 ) (err error) {
 
+	// dstTblName is the table this restore materializes into. It is normally
+	// the source table's own name (in-place restore, overwriting it), unless
+	// asTableName is set, in which case the snapshot is cloned into a new
+	// table left side-by-side with the live one.
+	dstTblName := tblInfo.tblName
+	if asTableName != "" {
+		dstTblName = asTableName
+	}
+
 	getLogger(sid).Debug(
 		fmt.Sprintf("[%s] start to restore table: %v, restore timestamp: %d",
 			snapshotName, tblInfo.tblName, snapshotTs))
@@ -1448,13 +1519,22 @@ func recreateTable(
 		return
 	}
 
-	getLogger(sid).Debug(fmt.Sprintf("[%s] start to drop table: %v,", snapshotName, tblInfo.tblName))
-	sql := fmt.Sprintf("drop table if exists `%s`", tblInfo.tblName)
-	if err = bh.Exec(ctx, sql); err != nil {
-		return
+	if asTableName == "" {
+		// in-place restore: drop the current table before recreating it from
+		// the snapshot. Restoring into a new name must not touch whatever
+		// table (if any) already sits under that name, so it skips this and
+		// lets the create below fail if dstTblName is already taken.
+		getLogger(sid).Debug(fmt.Sprintf("[%s] start to drop table: %v,", snapshotName, tblInfo.tblName))
+		sql := fmt.Sprintf("drop table if exists `%s`", tblInfo.tblName)
+		if err = bh.Exec(ctx, sql); err != nil {
+			return
+		}
 	}
 
 	if !isRestoreByCloneSql.MatchString(restoreTableDataByTsFmt) {
+		if asTableName != "" {
+			return moerr.NewInternalErrorf(ctx, "restore table %v as %v: not supported without the clone-based restore path", tblInfo.tblName, asTableName)
+		}
 		// create table
 		getLogger(sid).Debug(fmt.Sprintf("[%s] start to create table: %v, create table sql: %s", snapshotName, tblInfo.tblName, tblInfo.createSql))
 		if err = bh.Exec(ctx, tblInfo.createSql); err != nil {
@@ -1468,9 +1548,9 @@ func recreateTable(
 
 	if curAccountId == toAccountId {
 		// insert data
-		insertIntoSql := fmt.Sprintf(restoreTableDataByTsFmt, tblInfo.dbName, tblInfo.tblName, tblInfo.dbName, tblInfo.tblName, snapshotTs)
+		insertIntoSql := fmt.Sprintf(restoreTableDataByTsFmt, tblInfo.dbName, dstTblName, tblInfo.dbName, tblInfo.tblName, snapshotTs)
 		beginTime := time.Now()
-		getLogger(sid).Debug(fmt.Sprintf("[%s] start to insert select table: %v, insert sql: %s", snapshotName, tblInfo.tblName, insertIntoSql))
+		getLogger(sid).Debug(fmt.Sprintf("[%s] start to insert select table: %v, insert sql: %s", snapshotName, dstTblName, insertIntoSql))
 		if err = bh.Exec(ctx, insertIntoSql); err != nil {
 			if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) && !strings.Contains(err.Error(), tblInfo.tblName) {
 				err = nil
@@ -1478,15 +1558,15 @@ func recreateTable(
 				return err
 			}
 		}
-		getLogger(sid).Debug(fmt.Sprintf("[%s] insert select table: %v, cost: %v", snapshotName, tblInfo.tblName, time.Since(beginTime)))
+		getLogger(sid).Debug(fmt.Sprintf("[%s] insert select table: %v, cost: %v", snapshotName, dstTblName, time.Since(beginTime)))
 	} else {
-		insertIntoSql := fmt.Sprintf(restoreTableDataByNameFmt, tblInfo.dbName, tblInfo.tblName, tblInfo.dbName, tblInfo.tblName, snapshotName)
+		insertIntoSql := fmt.Sprintf(restoreTableDataByNameFmt, tblInfo.dbName, dstTblName, tblInfo.dbName, tblInfo.tblName, snapshotName)
 		beginTime := time.Now()
-		getLogger(sid).Debug(fmt.Sprintf("[%s] start to insert select table: %v, insert sql: %s", snapshotName, tblInfo.tblName, insertIntoSql))
+		getLogger(sid).Debug(fmt.Sprintf("[%s] start to insert select table: %v, insert sql: %s", snapshotName, dstTblName, insertIntoSql))
 		if err = bh.ExecRestore(ctx, insertIntoSql, curAccountId, toAccountId); err != nil {
 			return
 		}
-		getLogger(sid).Debug(fmt.Sprintf("[%s] insert select table: %v, cost: %v", snapshotName, tblInfo.tblName, time.Since(beginTime)))
+		getLogger(sid).Debug(fmt.Sprintf("[%s] insert select table: %v, cost: %v", snapshotName, dstTblName, time.Since(beginTime)))
 	}
 	return
 }
@@ -1661,12 +1741,12 @@ func getSqlForCheckSnapshotTs(snapshotTs int64) string {
 	return fmt.Sprintf(checkSnapshotTsFormat, snapshotTs)
 }
 
-func getSqlForCreateSnapshot(ctx context.Context, snapshotId, snapshotName string, ts int64, level, accountName, databaseName, tableName string, objectId uint64) (string, error) {
+func getSqlForCreateSnapshot(ctx context.Context, snapshotId, snapshotName string, ts int64, level, accountName, databaseName, tableName string, objectId uint64, createdBy, comment string) (string, error) {
 	err := inputNameIsInvalid(ctx, snapshotName)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(insertIntoMoSnapshots, snapshotId, snapshotName, ts, level, accountName, databaseName, tableName, objectId), nil
+	return fmt.Sprintf(insertIntoMoSnapshots, snapshotId, snapshotName, ts, level, accountName, databaseName, tableName, objectId, createdBy, comment), nil
 }
 
 func getSqlForDropSnapshot(snapshotName string) string {
@@ -89,6 +89,12 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 			return moerr.NewInternalError(execCtx.reqCtx, "select without it generates the result rows")
 		}
 	case *tree.CreateTable:
+		if !st.Temporary {
+			if err = checkCreateTableQuota(execCtx.reqCtx, ses); err != nil {
+				return
+			}
+		}
+
 		runBegin := time.Now()
 		if execCtx.runResult, err = execCtx.runner.Run(0); err != nil {
 			return
@@ -1293,3 +1293,17 @@ func (mr *MockWorkspaceMockRecorder) UpdateSnapshotWriteOffset() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotWriteOffset", reflect.TypeOf((*MockWorkspace)(nil).UpdateSnapshotWriteOffset))
 }
+
+// WriteStats mocks base method.
+func (m *MockWorkspace) WriteStats() client.WriteStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteStats")
+	ret0, _ := ret[0].(client.WriteStats)
+	return ret0
+}
+
+// WriteStats indicates an expected call of WriteStats.
+func (mr *MockWorkspaceMockRecorder) WriteStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteStats", reflect.TypeOf((*MockWorkspace)(nil).WriteStats))
+}
@@ -71,6 +71,12 @@ func (txn *testWorkspace) GetSnapshotWriteOffset() int {
 	return 0
 }
 
+func (txn *testWorkspace) WriteStats() client.WriteStats {
+	//TODO implement me
+	// panic("implement me")
+	return client.WriteStats{}
+}
+
 func newTestWorkspace() *testWorkspace {
 	return &testWorkspace{}
 }
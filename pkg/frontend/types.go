@@ -133,6 +133,7 @@ const (
 	FPShowBackendServers
 	FPSetTransaction
 	FPBackupStart
+	FPVerifyBackup
 	FPCreateSnapShot
 	FPDropSnapShot
 	FPRestoreSnapShot
@@ -1414,6 +1414,19 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("mo_pk_check_by_dn"),
 		Default:           int8(0),
 	},
+	// enable_shared_scan reserves the on/off switch for sharing one physical
+	// table scan's IO across concurrent queries against the same table.
+	// Default off: the scan-sharing mechanism itself (a scan operator that
+	// fans its blocks out to multiple consumer pipelines) is not implemented
+	// yet, so turning this on has no effect today.
+	"enable_shared_scan": {
+		Name:              "enable_shared_scan",
+		Scope:             ScopeSession,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("enable_shared_scan"),
+		Default:           int8(0),
+	},
 	"net_buffer_length": {
 		Name:              "net_buffer_length",
 		Scope:             ScopeBoth,
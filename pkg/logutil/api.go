@@ -82,15 +82,8 @@ func Fatalf(msg string, fields ...interface{}) {
 	GetSkip1Logger().Fatal(fmt.Sprintf(msg, fields...))
 }
 
-// TODO: uncomment the function when changing log level at runtime is required
-//func handleLevelChange(port string, pattern string, level zap.AtomicLevel) {
-//	http.HandleFunc(pattern, level.ServeHTTP)
-//	go func() {
-//		if err := http.ListenAndServe(port, nil); err != nil {
-//			panic(err)
-//		}
-//	}()
-//}
+// Runtime log level changes are handled per-module via SetModuleLogLevel
+// instead of a ServeHTTP-exposed AtomicLevel -- see module_level.go.
 
 type GoettyLogger struct{}
 
@@ -81,6 +81,7 @@ func GetErrorLogger() *zap.Logger {
 
 // replaceGlobalLogger replaces the current global zap Logger.
 func replaceGlobalLogger(logger *zap.Logger) {
+	logger = withModuleAwareCore(logger)
 	_globalLogger.Store(logger)
 	_skip1Logger.Store(logger.WithOptions(zap.AddCallerSkip(1)))
 	_errorLogger.Store(logger.WithOptions(zap.AddCallerSkip(1)))
@@ -0,0 +1,147 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logutil
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// moduleOverride is a temporary level override for every logger obtained via
+// Named(module) (e.g. runtime.ServiceRuntime(sid).Logger().Named("txnimpl")),
+// installed by mo_ctl('cn', 'log', 'module=txnimpl,level=debug,ttl=10m') to
+// chase down an issue like a commit stall or a flush anomaly without raising
+// the log level for the whole process and without a restart.
+type moduleOverride struct {
+	level zapcore.Level
+	timer *time.Timer
+}
+
+var (
+	moduleOverridesMu sync.Mutex
+	moduleOverrides   = make(map[string]*moduleOverride)
+)
+
+// SetModuleLogLevel overrides the log level for loggerName for ttl, after
+// which it automatically reverts to following the global log level again.
+// Calling it again for the same loggerName replaces the previous override
+// and restarts its ttl.
+func SetModuleLogLevel(loggerName string, level zapcore.Level, ttl time.Duration) {
+	moduleOverridesMu.Lock()
+	defer moduleOverridesMu.Unlock()
+
+	if old, ok := moduleOverrides[loggerName]; ok {
+		old.timer.Stop()
+	}
+
+	o := &moduleOverride{level: level}
+	o.timer = time.AfterFunc(ttl, func() { ClearModuleLogLevel(loggerName) })
+	moduleOverrides[loggerName] = o
+}
+
+// ClearModuleLogLevel removes loggerName's level override, if any, reverting
+// it to following the global log level immediately.
+func ClearModuleLogLevel(loggerName string) {
+	moduleOverridesMu.Lock()
+	defer moduleOverridesMu.Unlock()
+
+	if old, ok := moduleOverrides[loggerName]; ok {
+		old.timer.Stop()
+		delete(moduleOverrides, loggerName)
+	}
+}
+
+func lookupModuleLevel(loggerName string) (zapcore.Level, bool) {
+	moduleOverridesMu.Lock()
+	defer moduleOverridesMu.Unlock()
+
+	o, ok := moduleOverrides[loggerName]
+	if !ok {
+		return 0, false
+	}
+	return o.level, true
+}
+
+// anyModuleOverrideEnables reports whether some active override would let
+// level through. Core.Enabled has no logger name to check against, so this
+// errs towards letting the entry reach Check, which does have the name and
+// makes the real per-module decision.
+func anyModuleOverrideEnables(level zapcore.Level) bool {
+	moduleOverridesMu.Lock()
+	defer moduleOverridesMu.Unlock()
+
+	for _, o := range moduleOverrides {
+		if level >= o.level {
+			return true
+		}
+	}
+	return false
+}
+
+// moduleAwareCore wraps a zapcore.Core so that entries from a logger whose
+// name (or the leaf of a dotted Named() chain, e.g. "cn-service.txnimpl")
+// matches an active SetModuleLogLevel override are checked against that
+// module's level instead of the core's own base level.
+type moduleAwareCore struct {
+	zapcore.Core
+}
+
+func newModuleAwareCore(core zapcore.Core) zapcore.Core {
+	return &moduleAwareCore{core}
+}
+
+func (c *moduleAwareCore) With(fields []zapcore.Field) zapcore.Core {
+	return &moduleAwareCore{c.Core.With(fields)}
+}
+
+// Enabled overrides the embedded Core's Enabled so that zap's fast-path
+// level check (done before LoggerName is available) doesn't drop an entry
+// that a module-specific override would otherwise let through.
+func (c *moduleAwareCore) Enabled(level zapcore.Level) bool {
+	return c.Core.Enabled(level) || anyModuleOverrideEnables(level)
+}
+
+func (c *moduleAwareCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if level, ok := lookupModuleLevel(moduleLeafName(ent.LoggerName)); ok {
+		if ent.Level >= level {
+			return ce.AddCore(ent, c)
+		}
+		return ce
+	}
+	if c.Core.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// moduleLeafName returns the last segment of a dotted Named() chain, so an
+// override set on "txnimpl" still applies to a logger named
+// "cn-service.txnimpl".
+func moduleLeafName(loggerName string) string {
+	if idx := strings.LastIndex(loggerName, "."); idx >= 0 {
+		return loggerName[idx+1:]
+	}
+	return loggerName
+}
+
+// withModuleAwareCore wraps logger's core so module level overrides take
+// effect for it and every logger derived from it via Named()/With().
+func withModuleAwareCore(logger *zap.Logger) *zap.Logger {
+	return logger.WithOptions(zap.WrapCore(newModuleAwareCore))
+}
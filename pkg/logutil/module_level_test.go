@@ -0,0 +1,77 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestModuleAwareCore_OverridesOnlyNamedLogger(t *testing.T) {
+	defer ClearModuleLogLevel("txnimpl")
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(newModuleAwareCore(core))
+
+	SetModuleLogLevel("txnimpl", zapcore.DebugLevel, time.Minute)
+
+	logger.Named("txnimpl").Debug("debug from txnimpl")
+	logger.Debug("debug from base logger")
+
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, "debug from txnimpl", logs.All()[0].Message)
+}
+
+func TestModuleAwareCore_ExpiresAfterTTL(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(newModuleAwareCore(core))
+
+	SetModuleLogLevel("txnimpl", zapcore.DebugLevel, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+
+	logger.Named("txnimpl").Debug("debug from txnimpl")
+
+	require.Equal(t, 0, logs.Len())
+}
+
+func TestModuleAwareCore_MatchesLeafOfDottedName(t *testing.T) {
+	defer ClearModuleLogLevel("txnimpl")
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(newModuleAwareCore(core))
+
+	SetModuleLogLevel("txnimpl", zapcore.DebugLevel, time.Minute)
+
+	logger.Named("cn-service").Named("txnimpl").Debug("nested module debug")
+
+	require.Equal(t, 1, logs.Len())
+}
+
+func TestClearModuleLogLevel(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(newModuleAwareCore(core))
+
+	SetModuleLogLevel("txnimpl", zapcore.DebugLevel, time.Minute)
+	ClearModuleLogLevel("txnimpl")
+
+	logger.Named("txnimpl").Debug("debug from txnimpl")
+
+	require.Equal(t, 0, logs.Len())
+}
@@ -172,6 +172,16 @@ func (r *Bitmap) IsValid() bool {
 	return r != nil && r.bm != nil
 }
 
+// Slice returns a standalone, non-pooled Bitmap with every bit outside
+// [start, end) cleared. Use it to narrow a full, already-computed bitmap
+// down to the row range a caller is actually checking against.
+func (r *Bitmap) Slice(start, end uint64) Bitmap {
+	if r.bm == nil {
+		return NullBitmap
+	}
+	return Bitmap{bm: r.bm.Slice(start, end)}
+}
+
 func GetReusableBitmap() Bitmap {
 	var bm *bitmap.Bitmap
 	put := BitmapPool.Get(&bm)
@@ -73,6 +73,8 @@ func (b *ObjectBuffer) SetDataOptions(items ...WriteOptions) {
 		case WriteTS:
 			ts := item.Val.(time.Time)
 			b.vector.ExpireAt = ts
+		case WriteFenceToken:
+			b.vector.FenceToken = item.Val.(uint64)
 		default:
 			continue
 		}
@@ -0,0 +1,98 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+// DefaultCachePinBudget is the default ceiling, in bytes, on how much of the
+// CN block cache designated tables may claim. It only applies to tables
+// pinned through PinTable; it does not shrink the cache available to
+// everything else.
+const DefaultCachePinBudget = int64(512 * 1024 * 1024)
+
+// cachePinRegistry tracks the set of tables whose blocks should stay resident
+// in the CN block cache (see `ALTER TABLE t CACHE PIN`), and the aggregate
+// size budgeted for them. It is process-local: on a multi-CN cluster each CN
+// tracks its own pins.
+type cachePinRegistry struct {
+	sync.Mutex
+	budget int64
+	used   int64
+	tables map[uint64]int64 // tableID -> estimated resident bytes
+}
+
+var pinRegistry = &cachePinRegistry{
+	budget: DefaultCachePinBudget,
+}
+
+// SetCachePinBudget overrides the global pin budget, in bytes.
+func SetCachePinBudget(budget int64) {
+	pinRegistry.Lock()
+	defer pinRegistry.Unlock()
+	pinRegistry.budget = budget
+}
+
+// PinTable marks tableID as pinned, charging estimatedBytes against the
+// global pin budget. It returns moerr.ErrResourceNotEnough if the budget
+// would be exceeded.
+func PinTable(tableID uint64, estimatedBytes int64) error {
+	pinRegistry.Lock()
+	defer pinRegistry.Unlock()
+	if pinRegistry.tables == nil {
+		pinRegistry.tables = make(map[uint64]int64)
+	}
+	prev := pinRegistry.tables[tableID]
+	if pinRegistry.used-prev+estimatedBytes > pinRegistry.budget {
+		return moerr.NewInternalErrorNoCtxf(
+			"cache pin budget exceeded: used %d, requested %d, budget %d",
+			pinRegistry.used-prev, estimatedBytes, pinRegistry.budget,
+		)
+	}
+	pinRegistry.used += estimatedBytes - prev
+	pinRegistry.tables[tableID] = estimatedBytes
+	return nil
+}
+
+// UnpinTable releases tableID's pin and its share of the budget.
+func UnpinTable(tableID uint64) {
+	pinRegistry.Lock()
+	defer pinRegistry.Unlock()
+	if size, ok := pinRegistry.tables[tableID]; ok {
+		pinRegistry.used -= size
+		delete(pinRegistry.tables, tableID)
+	}
+}
+
+// IsTablePinned reports whether tableID is currently pinned.
+func IsTablePinned(tableID uint64) bool {
+	pinRegistry.Lock()
+	defer pinRegistry.Unlock()
+	_, ok := pinRegistry.tables[tableID]
+	return ok
+}
+
+// PolicyForTable adjusts a read/write Policy so that pinned tables are never
+// excluded from the memory cache, regardless of what the caller requested.
+func PolicyForTable(tableID uint64, policy fileservice.Policy) fileservice.Policy {
+	if IsTablePinned(tableID) {
+		return policy &^ fileservice.SkipMemoryCache
+	}
+	return policy
+}
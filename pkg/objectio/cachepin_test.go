@@ -0,0 +1,41 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+func TestCachePin(t *testing.T) {
+	defer SetCachePinBudget(DefaultCachePinBudget)
+	SetCachePinBudget(100)
+
+	require.False(t, IsTablePinned(1))
+	require.Equal(t, fileservice.SkipMemoryCache, PolicyForTable(1, fileservice.SkipMemoryCache))
+
+	require.NoError(t, PinTable(1, 60))
+	require.True(t, IsTablePinned(1))
+	require.Equal(t, fileservice.Policy(0), PolicyForTable(1, fileservice.SkipMemoryCache))
+
+	require.Error(t, PinTable(2, 60))
+
+	UnpinTable(1)
+	require.False(t, IsTablePinned(1))
+	require.NoError(t, PinTable(2, 60))
+}
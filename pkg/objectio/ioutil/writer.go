@@ -301,7 +301,7 @@ func (w *BlockWriter) WriteSubBatch(batch *batch.Batch, dataType objectio.DataMe
 	return w.writer.WriteSubBlock(batch, dataType)
 }
 
-func (w *BlockWriter) Sync(ctx context.Context) ([]objectio.BlockObject, objectio.Extent, error) {
+func (w *BlockWriter) Sync(ctx context.Context, items ...objectio.WriteOptions) ([]objectio.BlockObject, objectio.Extent, error) {
 	if w.objMetaBuilder != nil {
 		if w.isSetPK {
 			w.objMetaBuilder.SetPKNdv(w.pk, w.objMetaBuilder.GetTotalRow())
@@ -309,7 +309,7 @@ func (w *BlockWriter) Sync(ctx context.Context) ([]objectio.BlockObject, objecti
 		cnt, meta := w.objMetaBuilder.Build()
 		w.writer.WriteObjectMeta(ctx, cnt, meta)
 	}
-	blocks, err := w.writer.WriteEnd(ctx)
+	blocks, err := w.writer.WriteEnd(ctx, items...)
 	if len(blocks) == 0 {
 		logutil.Debug("[WriteEnd]", common.OperationField(w.nameStr),
 			common.OperandField("[Size=0]"), common.OperandField(w.writer.GetSeqnums()))
@@ -31,6 +31,11 @@ type WriteType int8
 
 const (
 	WriteTS WriteType = iota
+
+	// WriteFenceToken carries a uint64 lease epoch (e.g. a TN ReplicaID) that is
+	// forwarded to the underlying fileservice.IOVector.FenceToken, so a
+	// FencedFileService can reject writes from a writer that has lost its lease.
+	WriteFenceToken
 )
 
 type ZoneMap = index.ZM
@@ -118,6 +118,38 @@ func BatchDataNotNullCheck(vecs []*vector.Vector, attrs []string, tableDef *plan
 	return nil
 }
 
+// BatchDataCheckConstraint evaluates every CHECK constraint of tableDef, vectorized, over bat.
+// Following standard SQL CHECK semantics, a row satisfies a constraint unless the constraint's
+// expression evaluates to false for that row; a null result (e.g. one of the operands is null)
+// passes. The constraint's name is reported in the error so the caller doesn't have to guess
+// which check failed.
+func BatchDataCheckConstraint(proc *process.Process, bat *batch.Batch, tableDef *plan.TableDef) error {
+	for _, check := range tableDef.Checks {
+		executor, err := NewExpressionExecutor(proc, check.Check)
+		if err != nil {
+			return err
+		}
+		vec, err := executor.Eval(proc, []*batch.Batch{bat}, nil)
+		if err != nil {
+			executor.Free()
+			return err
+		}
+		nsp := vec.GetNulls()
+		violated := false
+		for i, v := range vector.MustFixedColWithTypeCheck[bool](vec) {
+			if !nsp.Contains(uint64(i)) && !v {
+				violated = true
+				break
+			}
+		}
+		executor.Free()
+		if violated {
+			return moerr.NewConstraintViolation(proc.Ctx, fmt.Sprintf("CHECK constraint '%s' is violated", check.Name))
+		}
+	}
+	return nil
+}
+
 func getRelationByObjRef(ctx context.Context, proc *process.Process, eg engine.Engine, ref *plan.ObjectRef) (engine.Relation, error) {
 	objName := ref.ObjName
 	if ses := proc.GetSession(); ses != nil {
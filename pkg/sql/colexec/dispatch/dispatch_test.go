@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/pSpool"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -348,3 +349,37 @@ func TestDataLossPrevention_ComparisonTable(t *testing.T) {
 		t.Log("SendToAny: Can failover to other receivers")
 	})
 }
+
+// Test_leastLoadedLocalReg checks that sendToAnyLocalFunc routes to the local
+// receiver with the shallowest pending queue instead of strict round robin
+// once one receiver has fallen behind.
+func Test_leastLoadedLocalReg(t *testing.T) {
+	proc := testutil.NewProcess(t)
+
+	ctr := &container{
+		localRegsCnt: 2,
+		aliveRegCnt:  2,
+		sp:           pSpool.InitMyPipelineSpool(proc.Mp(), 2),
+	}
+	bat := batch.New(nil)
+	bat.SetRowCount(1)
+
+	// pile up two batches on receiver 0, leaving receiver 1 empty.
+	for i := 0; i < 2; i++ {
+		_, err := ctr.sp.SendBatch(proc.Ctx, 0, bat, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, ctr.sp.QueueDepth(0))
+	require.Equal(t, 0, ctr.sp.QueueDepth(1))
+
+	// sendCnt=0 would round-robin to receiver 0, but it should pick receiver
+	// 1 instead because receiver 0 is already backed up.
+	require.Equal(t, 1, ctr.leastLoadedLocalReg())
+
+	// once both queues are level again, ties fall back to round robin.
+	_, err := ctr.sp.SendBatch(proc.Ctx, 1, bat, nil)
+	require.NoError(t, err)
+	_, err = ctr.sp.SendBatch(proc.Ctx, 1, bat, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, ctr.leastLoadedLocalReg())
+}
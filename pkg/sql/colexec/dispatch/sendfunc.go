@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"go.uber.org/zap"
 )
@@ -110,6 +111,9 @@ func sendBatToIndex(ap *Dispatch, proc *process.Process, bat *batch.Batch, shuff
 	for i := range ap.LocalRegs {
 		batIndex := uint32(ap.ShuffleRegIdxLocal[i])
 		if shuffleIndex == batIndex {
+			rows := float64(bat.RowCount())
+			v2.TxnShuffleLocalSendRowCounter.Add(rows)
+			v2.RecordShuffleLocalityStats(proc.QueryId(), int64(rows), 0)
 			queryDone, err = ap.ctr.sp.SendBatch(proc.Ctx, i, bat, nil)
 			if err != nil || queryDone {
 				return err
@@ -125,6 +129,8 @@ func sendBatToIndex(ap *Dispatch, proc *process.Process, bat *batch.Batch, shuff
 		batIndex := uint32(ap.ctr.remoteToIdx[r.Uid])
 		if shuffleIndex == batIndex {
 			if bat != nil && !bat.IsEmpty() {
+				v2.TxnShuffleRemoteSendRowCounter.Add(float64(bat.RowCount()))
+				v2.RecordShuffleLocalityStats(proc.QueryId(), 0, int64(bat.RowCount()))
 				receiverID := fmt.Sprintf("%s(ShuffleIdx=%d)", r.Uid.String(), shuffleIndex)
 				encodeData, errEncode := bat.MarshalBinaryWithBuffer(&ap.ctr.marshalBuf, true)
 				if errEncode != nil {
@@ -258,7 +264,7 @@ func onlyOneRegToDealThis(sendto int, ap *Dispatch) {
 // if the reg which you want to send to is closed
 // send it to next one.
 func sendToAnyLocalFunc(bat *batch.Batch, ap *Dispatch, proc *process.Process) (bool, error) {
-	sendto := ap.ctr.sendCnt % ap.ctr.localRegsCnt
+	sendto := ap.ctr.leastLoadedLocalReg()
 
 	queryDone, err := ap.ctr.sp.SendBatch(proc.Ctx, sendto, bat, nil)
 	if err != nil || queryDone {
@@ -271,6 +277,25 @@ func sendToAnyLocalFunc(bat *batch.Batch, ap *Dispatch, proc *process.Process) (
 	return false, nil
 }
 
+// leastLoadedLocalReg picks which local receiver the next batch goes to: the
+// one with the shallowest pending queue, starting the scan from the plain
+// round-robin pick. That way a downstream worker that's fallen behind (say,
+// because the plan's selectivity estimate for its share of the work was
+// wrong) gets skipped in favor of one that's keeping up, while receivers
+// that are equally caught up still get the old round-robin order.
+func (ctr *container) leastLoadedLocalReg() int {
+	start := ctr.sendCnt % ctr.localRegsCnt
+	best := start
+	bestDepth := ctr.sp.QueueDepth(best)
+	for i := 1; i < ctr.localRegsCnt; i++ {
+		idx := (start + i) % ctr.localRegsCnt
+		if d := ctr.sp.QueueDepth(idx); d < bestDepth {
+			best, bestDepth = idx, d
+		}
+	}
+	return best
+}
+
 // common sender: send to any RemoteReceiver
 // if the reg which you want to send to is closed
 // send it to next one.
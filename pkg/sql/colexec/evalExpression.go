@@ -571,6 +571,83 @@ func (expr *FunctionExpressionExecutor) EvalCase(proc *process.Process, batches
 	return err
 }
 
+// EvalAnd evaluates and/or's parameters left to right, narrowing the
+// selection passed to each subsequent parameter so that once a row's
+// result is already decided (false for AND, true for OR) that row's
+// later operands are not evaluated. This does not change the result:
+// opMultiAnd/opMultiOr short-circuit the same way at the row level
+// (false && anything == false, regardless of what "anything" turns out
+// to be), so skipping the evaluation of already-decided rows is safe.
+func (expr *FunctionExpressionExecutor) EvalAnd(proc *process.Process, batches []*batch.Batch, selectList []bool) (err error) {
+	rowCount := batches[0].RowCount()
+	if len(expr.selectList1) < rowCount {
+		expr.selectList1 = make([]bool, rowCount)
+	}
+	if selectList != nil {
+		copy(expr.selectList1, selectList)
+	} else {
+		for i := range expr.selectList1[:rowCount] {
+			expr.selectList1[i] = true
+		}
+	}
+
+	for i := range expr.parameterExecutor {
+		expr.parameterResults[i], err = expr.parameterExecutor[i].Eval(proc, batches, expr.selectList1[:rowCount])
+		if err != nil {
+			return err
+		}
+		if i != len(expr.parameterExecutor)-1 {
+			bs := vector.GenerateFunctionFixedTypeParameter[bool](expr.parameterResults[i])
+			for j := 0; j < rowCount; j++ {
+				if !expr.selectList1[j] {
+					continue
+				}
+				b, null := bs.GetValue(uint64(j))
+				if !null && !b {
+					expr.selectList1[j] = false
+				}
+			}
+		}
+	}
+	return err
+}
+
+// EvalOr is EvalAnd's mirror: a row is decided once one of its operands is
+// true, so every operand after that one is skipped for that row.
+func (expr *FunctionExpressionExecutor) EvalOr(proc *process.Process, batches []*batch.Batch, selectList []bool) (err error) {
+	rowCount := batches[0].RowCount()
+	if len(expr.selectList1) < rowCount {
+		expr.selectList1 = make([]bool, rowCount)
+	}
+	if selectList != nil {
+		copy(expr.selectList1, selectList)
+	} else {
+		for i := range expr.selectList1[:rowCount] {
+			expr.selectList1[i] = true
+		}
+	}
+
+	for i := range expr.parameterExecutor {
+		expr.parameterResults[i], err = expr.parameterExecutor[i].Eval(proc, batches, expr.selectList1[:rowCount])
+		if err != nil {
+			return err
+		}
+		if i != len(expr.parameterExecutor)-1 {
+			bs := vector.GenerateFunctionFixedTypeParameter[bool](expr.parameterResults[i])
+			for j := 0; j < rowCount; j++ {
+				if !expr.selectList1[j] {
+					continue
+				}
+				b, null := bs.GetValue(uint64(j))
+				if !null && b {
+					expr.selectList1[j] = false
+				}
+			}
+		}
+	}
+	return err
+}
+
 func (expr *FunctionExpressionExecutor) Eval(proc *process.Process, batches []*batch.Batch, selectList []bool) (*vector.Vector, error) {
 	if expr.folded.needFoldingCheck {
 		if err := expr.doFold(proc, proc.GetBaseProcessRunningStatus()); err != nil {
@@ -595,6 +672,16 @@ func (expr *FunctionExpressionExecutor) Eval(proc *process.Process, batches []*b
 		if err != nil {
 			return nil, err
 		}
+	} else if expr.fid == function.AND {
+		err = expr.EvalAnd(proc, batches, selectList)
+		if err != nil {
+			return nil, err
+		}
+	} else if expr.fid == function.OR {
+		err = expr.EvalOr(proc, batches, selectList)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		for i := range expr.parameterExecutor {
 			expr.parameterResults[i], err = expr.parameterExecutor[i].Eval(proc, batches, selectList)
@@ -1774,6 +1774,16 @@ func (h *ParquetHandler) getData(bat *batch.Batch, param *ExternalParam, proc *p
 	return h.getDataByPage(bat, param, proc)
 }
 
+// getDataByPage is the non-nested-column fast path: it walks each requested
+// column's page iterator independently (h.pages, seeded in prepare from
+// param.Attrs) and decodes pages straight into the output vector. Only the
+// columns referenced by param.Attrs get a *parquet.Column/pages at all, so
+// column pruning/projection pushdown already happens here.
+//
+// Predicate pushdown against Parquet's own row-group/page statistics isn't
+// implemented: param.Filter.FilterExpr is evaluated generically after a
+// batch is decoded, the same as for CSV/jsonline sources, so every page is
+// decoded even when its stats would rule it out.
 func (h *ParquetHandler) getDataByPage(bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
 	length := 0
 	finish := false
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/util/diagnostics"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -96,6 +97,7 @@ func (filter *Filter) Call(proc *process.Process) (vm.CallResult, error) {
 		}
 
 		if proc.OperatorOutofMemory(int64(vec.Size())) {
+			captureOOMDiagnostics(proc)
 			return vm.CancelResult, moerr.NewOOM(proc.Ctx)
 		}
 		analyzer.Alloc(int64(vec.Size()))
@@ -199,3 +201,24 @@ func (ctr *container) shrinkWithSels(proc *process.Process, bat *batch.Batch, se
 	}
 	return ctr.buf, nil
 }
+
+// captureOOMDiagnostics best-effort dumps a diagnostics bundle (memory
+// accounting and the failing statement) to the ETL fileservice when a
+// query's operator memory budget is exceeded, for postmortem. It never
+// affects the OOM error this operator is about to return: a failed or
+// skipped capture is only logged.
+func captureOOMDiagnostics(proc *process.Process) {
+	sections := map[string]string{}
+	if sp := proc.GetStmtProfile(); sp != nil {
+		sections["statement"] = sp.GetSqlOfStmt()
+	}
+	_ = diagnostics.Capture(
+		proc.Ctx,
+		proc.GetFileService(),
+		"CN",
+		proc.GetService(),
+		"oom",
+		proc.Mp(),
+		sections,
+	)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -256,7 +257,31 @@ func (ctr *container) handleRuntimeFilter(hashBuild *HashBuild, proc *process.Pr
 	}()
 
 	if hashmapCount > uint64(inFilterCardLimit) {
-		runtimeFilter.Typ = message.RuntimeFilter_PASS
+		// Past inFilterCardLimit an exact IN-list stops being worth shipping
+		// and probing row by row, but up to BloomFilterCardLimit a
+		// single-column key is still worth sending as a runtime filter: the
+		// probe side (waitForRuntimeFilters in compile/scope.go) already
+		// knows how to turn RuntimeFilter_BLOOMFILTER's unique-key vector
+		// into the same in(...) predicate it builds for RuntimeFilter_IN -
+		// this is the same "ship the keys, let the consumer decide" shape
+		// the ivfflat runtime filter below already uses. Composite keys
+		// still fall back to PASS like before.
+		if spec.Expr.GetF() != nil || hashmapCount > uint64(plan2.BloomFilterCardLimit) {
+			runtimeFilter.Typ = message.RuntimeFilter_PASS
+			message.SendRuntimeFilter(runtimeFilter, spec, proc.GetMessageBoard())
+			return nil
+		}
+
+		rowCount := ctr.hashmapBuilder.UniqueJoinKeys[0].Length()
+		ctr.hashmapBuilder.UniqueJoinKeys[0].InplaceSort()
+		data, err := ctr.hashmapBuilder.UniqueJoinKeys[0].MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		runtimeFilter.Typ = message.RuntimeFilter_BLOOMFILTER
+		runtimeFilter.Card = int32(rowCount)
+		runtimeFilter.Data = data
 		message.SendRuntimeFilter(runtimeFilter, spec, proc.GetMessageBoard())
 		return nil
 	} else {
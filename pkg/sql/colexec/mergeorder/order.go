@@ -16,7 +16,9 @@ package mergeorder
 
 import (
 	"bytes"
+	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -102,7 +104,9 @@ func (ctr *container) pickAndSend(proc *process.Process, result *vm.CallResult)
 		wholeLength++
 		ctr.indexList[choice]++
 		if ctr.indexList[choice] == int64(ctr.batchList[choice].RowCount()) {
-			ctr.removeBatch(proc, choice)
+			if err = ctr.advanceOrRemove(proc, choice); err != nil {
+				return false, err
+			}
 		}
 
 		if len(ctr.indexList) == 0 {
@@ -145,16 +149,20 @@ func (ctr *container) pickFirstRow() (batIndex int) {
 }
 
 func (ctr *container) removeBatch(proc *process.Process, index int) {
-	bat := ctr.batchList[index]
-	cols := ctr.orderCols[index]
+	freeRunBatch(proc, ctr.batchList[index], ctr.orderCols[index])
+	ctr.batchList = append(ctr.batchList[:index], ctr.batchList[index+1:]...)
+	ctr.indexList = append(ctr.indexList[:index], ctr.indexList[index+1:]...)
+	ctr.orderCols = append(ctr.orderCols[:index], ctr.orderCols[index+1:]...)
+	if ctr.runReaders != nil {
+		ctr.runReaders = append(ctr.runReaders[:index], ctr.runReaders[index+1:]...)
+	}
+}
 
+func freeRunBatch(proc *process.Process, bat *batch.Batch, cols []*vector.Vector) {
 	alreadyPut := make(map[*vector.Vector]bool, len(bat.Vecs))
 	for i := range bat.Vecs {
 		alreadyPut[bat.Vecs[i]] = true
 	}
-	ctr.batchList = append(ctr.batchList[:index], ctr.batchList[index+1:]...)
-	ctr.indexList = append(ctr.indexList[:index], ctr.indexList[index+1:]...)
-
 	for i := range cols {
 		if _, ok := alreadyPut[cols[i]]; ok {
 			continue
@@ -164,7 +172,128 @@ func (ctr *container) removeBatch(proc *process.Process, index int) {
 	for v := range alreadyPut {
 		v.Free(proc.GetMPool())
 	}
-	ctr.orderCols = append(ctr.orderCols[:index], ctr.orderCols[index+1:]...)
+}
+
+// advanceOrRemove is removeBatch's spill-aware counterpart: if the run at
+// index is backed by a spill file, it tries to refill it with that run's
+// next on-disk batch instead of dropping it, and only falls back to
+// removeBatch once the file is exhausted. Runs that were never spilled
+// (runReaders == nil or the entry is nil) behave exactly as before.
+func (ctr *container) advanceOrRemove(proc *process.Process, index int) error {
+	if ctr.runReaders == nil || ctr.runReaders[index] == nil {
+		ctr.removeBatch(proc, index)
+		return nil
+	}
+
+	next, err := ctr.runReaders[index].next(proc.GetMPool())
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		ctr.runReaders[index].close()
+		ctr.runReaders[index] = nil
+		ctr.removeBatch(proc, index)
+		return nil
+	}
+
+	freeRunBatch(proc, ctr.batchList[index], ctr.orderCols[index])
+	ctr.batchList[index] = next
+	ctr.indexList[index] = 0
+	ctr.orderCols[index] = nil
+	return ctr.evaluateOrderColumn(proc, index)
+}
+
+// spillCurrentRuns flushes every run currently buffered in batchList to a
+// new spill file, one run after another via MarshalBinaryWithBuffer, and
+// drops them from memory. UnmarshalFromReader is self-delimiting, so the
+// runs can later be read back one at a time with no extra framing.
+func (ctr *container) spillCurrentRuns(proc *process.Process) error {
+	if len(ctr.batchList) == 0 {
+		return nil
+	}
+
+	spillfs, err := proc.GetSpillFileService()
+	if err != nil {
+		return err
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+	f, err := spillfs.CreateAndRemoveFile(proc.Ctx, fmt.Sprintf("merge_order_%s", id.String()))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, bat := range ctr.batchList {
+		buf.Reset()
+		if _, err = bat.MarshalBinaryWithBuffer(&buf, false); err != nil {
+			f.Close()
+			return err
+		}
+		if _, err = f.Write(buf.Bytes()); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if _, err = f.Seek(0, 0); err != nil {
+		f.Close()
+		return err
+	}
+	ctr.spillFiles = append(ctr.spillFiles, f)
+
+	for i := range ctr.batchList {
+		freeRunBatch(proc, ctr.batchList[i], ctr.orderCols[i])
+	}
+	ctr.batchList = ctr.batchList[:0]
+	ctr.orderCols = ctr.orderCols[:0]
+	ctr.curMem = 0
+	return nil
+}
+
+// prepareExternalMerge is the spilling counterpart of the plain
+// pickUpSending setup below: it loads the first batch back out of every
+// spill file as a refillable run and mixes them in with whatever runs are
+// still sitting in memory, then wires up compares/indexList as usual.
+func (mergeOrder *MergeOrder) prepareExternalMerge(proc *process.Process) error {
+	ctr := &mergeOrder.ctr
+
+	ctr.runReaders = make([]*runReader, len(ctr.batchList))
+	for i := range ctr.batchList {
+		if ctr.orderCols[i] == nil {
+			if err := ctr.evaluateOrderColumn(proc, i); err != nil {
+				return err
+			}
+		}
+	}
+
+	spillFiles := ctr.spillFiles
+	ctr.spillFiles = nil
+	for _, f := range spillFiles {
+		r := &runReader{f: f}
+		bat, err := r.next(proc.GetMPool())
+		if err != nil {
+			r.close()
+			return err
+		}
+		if bat == nil {
+			// an empty spill file shouldn't happen, but don't merge a
+			// phantom run if it does.
+			r.close()
+			continue
+		}
+		ctr.batchList = append(ctr.batchList, bat)
+		ctr.orderCols = append(ctr.orderCols, nil)
+		ctr.runReaders = append(ctr.runReaders, r)
+		if err = ctr.evaluateOrderColumn(proc, len(ctr.batchList)-1); err != nil {
+			return err
+		}
+	}
+
+	ctr.generateCompares(mergeOrder.OrderBySpecs)
+	ctr.indexList = make([]int64, len(ctr.batchList))
+	return nil
 }
 
 func (mergeOrder *MergeOrder) String(buf *bytes.Buffer) {
@@ -195,6 +324,7 @@ func (mergeOrder *MergeOrder) Prepare(proc *process.Process) (err error) {
 	if len(mergeOrder.ctr.executors) == 0 {
 		ctr.batchList = make([]*batch.Batch, 0, defaultCacheBatchSize)
 		ctr.orderCols = make([][]*vector.Vector, 0, defaultCacheBatchSize)
+		ctr.setSpillMem(mergeOrder.SpillMem)
 
 		mergeOrder.ctr.executors = make([]colexec.ExpressionExecutor, len(mergeOrder.OrderBySpecs))
 		for i := range mergeOrder.ctr.executors {
@@ -220,6 +350,17 @@ func (mergeOrder *MergeOrder) Call(proc *process.Process) (vm.CallResult, error)
 			}
 
 			if input.Batch == nil {
+				if len(ctr.spillFiles) > 0 {
+					// some runs already spilled to disk: merge those with
+					// whatever is still buffered in memory, regardless of
+					// how many runs that leaves in memory.
+					if err = mergeOrder.prepareExternalMerge(proc); err != nil {
+						return vm.CancelResult, err
+					}
+					ctr.status = pickUpSending
+					continue
+				}
+
 				// if number of block is less than 2, no need to do merge sort.
 				ctr.status = normalSending
 
@@ -245,9 +386,15 @@ func (mergeOrder *MergeOrder) Call(proc *process.Process) (vm.CallResult, error)
 				return vm.CancelResult, err
 			}
 			analyzer.Alloc(int64(bat.Size()))
+			ctr.curMem += int64(bat.Size())
 			if err = ctr.mergeAndEvaluateOrderColumn(proc, bat); err != nil {
 				return vm.CancelResult, err
 			}
+			if ctr.curMem >= ctr.spillMem {
+				if err = ctr.spillCurrentRuns(proc); err != nil {
+					return vm.CancelResult, err
+				}
+			}
 
 		case normalSending:
 			if len(ctr.batchList) == 0 {
@@ -15,8 +15,12 @@
 package mergeorder
 
 import (
+	"io"
+	"os"
+
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/common/reuse"
+	"github.com/matrixorigin/matrixone/pkg/common/system"
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -29,6 +33,11 @@ import (
 const maxBatchSizeToSend = 64 * mpool.MB
 const defaultCacheBatchSize = 16
 
+// minSpillMem is the floor for the auto-computed in-memory budget that
+// receiving buffers runs up to before flushing them to disk, mirroring
+// group's setSpillMem floor.
+const minSpillMem = 128 * mpool.MB
+
 var _ vm.Operator = new(MergeOrder)
 
 const (
@@ -43,6 +52,10 @@ type MergeOrder struct {
 
 	OrderBySpecs []*plan.OrderBySpec
 
+	// SpillMem is the memory budget for runs buffered in receiving before
+	// they are flushed to disk and merged externally. 0 means auto-config.
+	SpillMem int64
+
 	vm.OperatorBase
 }
 
@@ -92,11 +105,75 @@ type container struct {
 	compares  []compare.Compare
 
 	buf *batch.Batch
+
+	// spill support: once the in-memory runs buffered during receiving grow
+	// past spillMem, the whole batchList is flushed to a new file in
+	// spillFiles and receiving continues with an empty buffer. runReaders
+	// mirrors batchList/orderCols/indexList one-to-one once pickUpSending
+	// starts: a non-nil entry means that run's current batch can be
+	// refilled from disk once it is fully consumed, instead of being
+	// dropped for good.
+	curMem     int64
+	spillMem   int64
+	spillFiles []*os.File
+	runReaders []*runReader
+}
+
+// runReader streams the sequence of batches a spill file was written with
+// back out one at a time. The file itself was opened via
+// fileservice.MutableFileService.CreateAndRemoveFile, so it already has no
+// directory entry; closing it is enough to release its disk space.
+type runReader struct {
+	f *os.File
+}
+
+func (r *runReader) next(mp *mpool.MPool) (*batch.Batch, error) {
+	bat := batch.NewWithSize(0)
+	if err := bat.UnmarshalFromReader(r.f, mp); err != nil {
+		bat.Clean(mp)
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return bat, nil
+}
+
+func (r *runReader) close() {
+	r.f.Close()
+}
+
+func (ctr *container) setSpillMem(m int64) {
+	if m == 0 {
+		// 0 means auto config, same formula group uses for agg spilling.
+		mem := int64(system.MemoryTotal()) / int64(system.GoMaxProcs()) / 8
+		if mem < minSpillMem {
+			mem = minSpillMem
+		}
+		ctr.spillMem = mem
+		return
+	}
+	ctr.spillMem = m
+}
+
+func (ctr *container) closeSpillFiles() {
+	for _, f := range ctr.spillFiles {
+		f.Close()
+	}
+	ctr.spillFiles = nil
+	for _, r := range ctr.runReaders {
+		if r != nil {
+			r.close()
+		}
+	}
+	ctr.runReaders = nil
 }
 
 func (mergeOrder *MergeOrder) Reset(proc *process.Process, pipelineFailed bool, err error) {
 	mergeOrder.cleanBatchAndCol(proc)
 	ctr := &mergeOrder.ctr
+	ctr.closeSpillFiles()
+	ctr.curMem = 0
 	ctr.batchList = ctr.batchList[:0]
 	ctr.orderCols = ctr.orderCols[:0]
 	ctr.indexList = nil
@@ -115,6 +192,7 @@ func (mergeOrder *MergeOrder) Reset(proc *process.Process, pipelineFailed bool,
 func (mergeOrder *MergeOrder) Free(proc *process.Process, pipelineFailed bool, err error) {
 	mergeOrder.cleanBatchAndCol(proc)
 	ctr := &mergeOrder.ctr
+	ctr.closeSpillFiles()
 	ctr.batchList = nil
 	ctr.orderCols = nil
 	for i := range ctr.executors {
@@ -17,6 +17,7 @@ package onduplicatekey
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -31,6 +32,11 @@ import (
 
 const opName = "on_duplicate_key"
 
+// conflictSampleLimit bounds how many conflicting rows we keep a
+// printable sample of per statement, so a batch full of duplicates doesn't
+// grow that sample without limit.
+const conflictSampleLimit = 10
+
 func (onDuplicatekey *OnDuplicatekey) String(buf *bytes.Buffer) {
 	buf.WriteString(opName)
 	buf.WriteString(": processing on duplicate key before insert")
@@ -53,6 +59,8 @@ func (onDuplicatekey *OnDuplicatekey) Prepare(p *process.Process) (err error) {
 			return
 		}
 	}
+	onDuplicatekey.ctr.conflictCount = 0
+	onDuplicatekey.ctr.conflictSample = nil
 	return
 }
 
@@ -94,6 +102,10 @@ func (onDuplicatekey *OnDuplicatekey) Call(proc *process.Process) (vm.CallResult
 	}
 }
 
+// resetInsertBatchForOnduplicateKey dedups the incoming rows against the
+// target table's rowid via an upstream join, then checkConflict below
+// catches duplicates within the same incoming batch O(n^2) per batch; see
+// checkConflict for the intra-batch case.
 func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch.Batch, insertArg *OnDuplicatekey) error {
 	//get rowid vec index
 	rowIdIdx := int32(-1)
@@ -149,6 +161,7 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 		if oldConflictIdx > -1 {
 
 			if insertArg.IsIgnore {
+				insertArg.recordConflict(newBatch)
 				continue
 			}
 
@@ -212,6 +225,7 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 			} else {
 
 				if insertArg.IsIgnore {
+					insertArg.recordConflict(newBatch)
 					newBatch.Clean(proc.GetMPool())
 					continue
 				}
@@ -255,6 +269,47 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 	return nil
 }
 
+// recordConflict accounts for a row dropped by IsIgnore mode: it bumps the
+// conflict count and, up to conflictSampleLimit, keeps a printable sample of
+// the unique-key column values of the dropped row.
+func (onDuplicatekey *OnDuplicatekey) recordConflict(row *batch.Batch) {
+	onDuplicatekey.ctr.conflictCount++
+	if len(onDuplicatekey.ctr.conflictSample) >= conflictSampleLimit {
+		return
+	}
+	onDuplicatekey.ctr.conflictSample = append(
+		onDuplicatekey.ctr.conflictSample,
+		conflictRowToString(row, onDuplicatekey.UniqueCols, onDuplicatekey.Attrs),
+	)
+}
+
+// conflictRowToString renders the unique-key columns of row (a single-row
+// batch) as "(col=val, ...)", deduplicating columns shared by more than one
+// unique key.
+func conflictRowToString(row *batch.Batch, uniqueCols []string, attrs []string) string {
+	colIdx := make(map[string]int32, len(attrs))
+	for i, name := range attrs {
+		colIdx[name] = int32(i)
+	}
+
+	seen := make(map[string]struct{})
+	parts := make([]string, 0, len(uniqueCols))
+	for _, cols := range uniqueCols {
+		for _, name := range strings.Split(cols, ",") {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			idx, ok := colIdx[name]
+			if !ok {
+				continue
+			}
+			parts = append(parts, fmt.Sprintf("%s=%s", name, row.Vecs[idx].RowToString(0)))
+		}
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
 func resetColPos(e *plan.Expr, columnCount int) {
 	switch tmpExpr := e.Expr.(type) {
 	case *plan.Expr_Col:
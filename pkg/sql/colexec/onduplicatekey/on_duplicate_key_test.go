@@ -84,6 +84,25 @@ func TestOnDuplicateKey(t *testing.T) {
 	}
 }
 
+func TestOnDuplicateKeyIgnore(t *testing.T) {
+	for _, tc := range makeTestCases(t) {
+		tc.arg.IsIgnore = true
+		resetChildren(tc.arg, tc.proc.Mp())
+		err := tc.arg.Prepare(tc.proc)
+		require.NoError(t, err)
+		ret, _ := vm.Exec(tc.arg, tc.proc)
+		// the second row conflicts with the first on "a" and gets dropped
+		// instead of merged, so only one row survives.
+		require.Equal(t, 1, ret.Batch.RowCount())
+		require.Equal(t, uint64(1), tc.arg.GetConflictCount())
+		require.Equal(t, 1, len(tc.arg.GetConflictSample()))
+
+		tc.arg.Free(tc.proc, false, nil)
+		tc.proc.Free()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
 func resetChildren(arg *OnDuplicatekey, m *mpool.MPool) {
 	bat := batch.New([]string{"a", "b", "a", "b", catalog.Row_ID})
 	vecs := make([]*vector.Vector, 5)
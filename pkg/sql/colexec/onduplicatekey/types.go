@@ -36,6 +36,13 @@ type container struct {
 	checkConflictBat *batch.Batch // batch to check conflict
 	rbat             *batch.Batch // return batch
 	uniqueCheckExes  []colexec.ExpressionExecutor
+
+	// conflictCount and conflictSample only get populated in IsIgnore mode:
+	// they count how many rows were dropped for conflicting with an
+	// existing or an already-accepted row in this batch, and keep a small
+	// sample of those rows for diagnostics.
+	conflictCount  uint64
+	conflictSample []string
 }
 
 type OnDuplicatekey struct {
@@ -102,9 +109,25 @@ func (onDuplicatekey *OnDuplicatekey) Reset(proc *process.Process, pipelineFaile
 			exe.ResetForNextQuery()
 		}
 	}
+	onDuplicatekey.ctr.conflictCount = 0
+	onDuplicatekey.ctr.conflictSample = nil
 	onDuplicatekey.ctr.state = Build
 }
 
+// GetConflictCount returns how many rows this statement dropped because they
+// conflicted with an existing row or an already-accepted row earlier in the
+// same statement. It is only meaningful in IsIgnore mode: outside of it,
+// a conflict returns an error instead of being counted here.
+func (onDuplicatekey *OnDuplicatekey) GetConflictCount() uint64 {
+	return onDuplicatekey.ctr.conflictCount
+}
+
+// GetConflictSample returns a bounded sample of the rows GetConflictCount
+// counted, formatted for diagnostics.
+func (onDuplicatekey *OnDuplicatekey) GetConflictSample() []string {
+	return onDuplicatekey.ctr.conflictSample
+}
+
 func (onDuplicatekey *OnDuplicatekey) Free(proc *process.Process, pipelineFailed bool, err error) {
 	if onDuplicatekey.ctr.rbat != nil {
 		onDuplicatekey.ctr.rbat.Clean(proc.GetMPool())
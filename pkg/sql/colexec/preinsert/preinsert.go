@@ -226,6 +226,11 @@ func (preInsert *PreInsert) Call(proc *proc) (vm.CallResult, error) {
 	if err != nil {
 		return result, err
 	}
+	if len(preInsert.TableDef.Checks) > 0 {
+		if err = colexec.BatchDataCheckConstraint(proc, preInsert.ctr.buf, preInsert.TableDef); err != nil {
+			return result, err
+		}
+	}
 
 	if err = preInsert.constructHiddenColBuf(proc, bat, first); err != nil {
 		return result, err
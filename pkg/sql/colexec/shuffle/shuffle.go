@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -104,6 +105,7 @@ func (shuffle *Shuffle) Call(proc *process.Process) (vm.CallResult, error) {
 		if bat == nil {
 			shuffle.ctr.ending = true
 			shuffle.ctr.lastForShufflePool = shuffle.ctr.shufflePool.Ending()
+			shuffle.reportBucketBalance()
 			result.Batch = batch.EmptyBatch
 			return result, nil
 		} else if bat.Last() {
@@ -758,6 +760,7 @@ func rangeShuffle(ap *Shuffle, bat *batch.Batch, proc *process.Process) (*batch.
 		ok, regIndex := allBatchInOneRange(ap, bat)
 		if ok {
 			bat.ShuffleIDX = int32(regIndex)
+			ap.recordRangeBucketRows(int32(regIndex), bat.RowCount())
 			return bat, nil
 		}
 	}
@@ -768,14 +771,52 @@ func rangeShuffle(ap *Shuffle, bat *batch.Batch, proc *process.Process) (*batch.
 		sels = getShuffledSelsByRangeWithoutNull(ap, bat)
 	}
 	for i := range sels {
-		if len(sels[i]) > 0 && len(sels[i]) != bat.RowCount() {
-			break
-		}
 		if len(sels[i]) == bat.RowCount() {
 			bat.ShuffleIDX = int32(i)
+			ap.recordRangeBucketRows(int32(i), len(sels[i]))
 			return bat, nil
 		}
 	}
+	for i := range sels {
+		if len(sels[i]) > 0 {
+			ap.recordRangeBucketRows(int32(i), len(sels[i]))
+		}
+	}
 	err := ap.ctr.shufflePool.putBatchIntoShuffledPoolsBySels(bat, sels, proc)
 	return nil, err
 }
+
+// recordRangeBucketRows adds rows to the running per-bucket row count for this
+// range-shuffle operator instance. Counts are reported as bucket balance once
+// the operator finishes, see reportBucketBalance.
+func (shuffle *Shuffle) recordRangeBucketRows(bucket int32, rows int) {
+	if rows == 0 {
+		return
+	}
+	if shuffle.ctr.rangeBucketRows == nil {
+		shuffle.ctr.rangeBucketRows = make([]int64, shuffle.BucketNum)
+	}
+	shuffle.ctr.rangeBucketRows[bucket] += int64(rows)
+}
+
+// reportBucketBalance observes the skew between this operator instance's most
+// loaded bucket and its average bucket, as a coarse signal of how well the
+// chosen range split points balanced the data.
+func (shuffle *Shuffle) reportBucketBalance() {
+	counts := shuffle.ctr.rangeBucketRows
+	if len(counts) == 0 {
+		return
+	}
+	var total, max int64
+	for _, n := range counts {
+		total += n
+		if n > max {
+			max = n
+		}
+	}
+	if total == 0 {
+		return
+	}
+	avg := float64(total) / float64(len(counts))
+	v2.TxnShuffleBucketSkewHistogram.Observe(float64(max) / avg)
+}
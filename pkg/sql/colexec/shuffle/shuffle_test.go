@@ -340,6 +340,20 @@ func TestPrint(t *testing.T) {
 	sp.Print()
 }
 
+func TestRecordRangeBucketRows(t *testing.T) {
+	arg := &Shuffle{
+		ctr:       container{},
+		BucketNum: 3,
+	}
+	arg.recordRangeBucketRows(0, 10)
+	arg.recordRangeBucketRows(1, 5)
+	arg.recordRangeBucketRows(0, 0)
+	require.Equal(t, []int64{10, 5, 0}, arg.ctr.rangeBucketRows)
+	// must not panic with no rows recorded at all
+	empty := &Shuffle{ctr: container{}, BucketNum: 3}
+	empty.reportBucketBalance()
+}
+
 func getInputBats(tc shuffleTestCase, hasnull bool) []*batch.Batch {
 	return []*batch.Batch{
 		newBatch(tc.types, tc.proc, Rows, hasnull),
@@ -77,6 +77,10 @@ type container struct {
 	buf                  *batch.Batch
 	shufflePool          *ShufflePool
 	runtimeFilterHandled bool
+	// rangeBucketRows tracks, for range shuffle, how many rows this operator
+	// instance has routed to each bucket so far. It's only allocated on first
+	// use by rangeShuffle, and read once at the end to report bucket balance.
+	rangeBucketRows []int64
 }
 
 func (shuffle *Shuffle) SetShufflePool(sp *ShufflePool) {
@@ -0,0 +1,257 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// XXX: the row's column values are packed into a JSON text column rather
+// than mirrored into dynamically typed output columns, same limitation
+// metadata_scan has for MIN/MAX/SUM: there is no way here to bind a TVF's
+// output schema to a table that is only known from a runtime argument.
+//
+// Scope: only tables with a single-column primary key are supported, and
+// only commit_ts is surfaced as "txn metadata" - this engine does not
+// track broader per-row txn identity (txn id, user, session) that could
+// be queried back out of CollectChanges.
+//
+// commit_ts is only available here, and not as a pseudo-column on an
+// ordinary scan, because CollectChanges's tombstone batches carry it
+// explicitly (objectio.DefaultCommitTS_Attr) for delete visibility; live
+// data rows in a flushed object don't retain a per-row commit timestamp.
+type rowHistoryState struct {
+	simpleOneBatchState
+}
+
+func rowHistoryPrepare(proc *process.Process, tf *TableFunction) (tvfState, error) {
+	var err error
+	tf.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, tf.Args)
+	tf.ctr.argVecs = make([]*vector.Vector, len(tf.Args))
+	for i := range tf.Attrs {
+		tf.Attrs[i] = strings.ToUpper(tf.Attrs[i])
+	}
+	return &rowHistoryState{}, err
+}
+
+func (s *rowHistoryState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
+	s.startPreamble(tf, proc, nthRow)
+
+	if len(tf.ctr.argVecs) < 2 {
+		return moerr.NewInvalidInput(proc.Ctx, "row_history requires (table, pk_value) arguments")
+	}
+
+	tablePath := tf.ctr.argVecs[0].GetStringAt(nthRow)
+	dbname, tablename, ok := strings.Cut(tablePath, ".")
+	if !ok {
+		return moerr.NewInvalidInputf(proc.Ctx, "row_history table argument must be in db.table format, got %q", tablePath)
+	}
+
+	pkValue := fmt.Sprintf("%v", vector.GetAny(tf.ctr.argVecs[1], nthRow, false))
+
+	e := proc.Ctx.Value(defines.EngineKey{}).(engine.Engine)
+	db, err := e.Database(proc.Ctx, dbname, proc.GetTxnOperator())
+	if err != nil {
+		return moerr.NewInvalidInputf(proc.Ctx, "database %s not found: %v", dbname, err)
+	}
+	rel, err := db.Relation(proc.Ctx, tablename, nil)
+	if err != nil {
+		return moerr.NewInvalidInputf(proc.Ctx, "table %s.%s not found: %v", dbname, tablename, err)
+	}
+
+	pkAttrs, err := rel.GetPrimaryKeys(proc.Ctx)
+	if err != nil {
+		return err
+	}
+	if len(pkAttrs) != 1 {
+		return moerr.NewNotSupportedf(proc.Ctx,
+			"row_history only supports a single-column primary key, %s.%s has %d primary key columns",
+			dbname, tablename, len(pkAttrs))
+	}
+	pkName := pkAttrs[0].Name
+
+	fromTS, err := s.resolveFromTS(tf, proc, rel, nthRow)
+	if err != nil {
+		return err
+	}
+	toTS, err := s.resolveToTS(tf, proc, nthRow)
+	if err != nil {
+		return err
+	}
+
+	handle, err := rel.CollectChanges(proc.Ctx, fromTS, toTS, false, proc.GetMPool())
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+
+	for {
+		data, tombstone, _, err := handle.Next(proc.Ctx, proc.GetMPool())
+		if err != nil {
+			return err
+		}
+		if data == nil && tombstone == nil {
+			break
+		}
+		if data != nil {
+			if err = s.collectDataVersions(tf, proc, data, pkName, pkValue); err != nil {
+				return err
+			}
+		}
+		if tombstone != nil {
+			if err = s.collectTombstoneVersions(tf, proc, tombstone, pkValue); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveFromTS defaults to the earliest point the engine still retains
+// incremental history for, since an empty "from" would silently switch
+// CollectChanges into snapshot-at-"to" mode instead of returning history.
+func (s *rowHistoryState) resolveFromTS(tf *TableFunction, proc *process.Process, rel engine.Relation, nthRow int) (types.TS, error) {
+	if len(tf.ctr.argVecs) > 2 && !tf.ctr.argVecs[2].IsConstNull() {
+		physical, _, err := getInt64Value(proc, tf.ctr.argVecs[2], nthRow, false, "from_ts")
+		if err != nil {
+			return types.TS{}, err
+		}
+		return types.BuildTS(physical, 0), nil
+	}
+	return disttae.GetPartitionStateStart(proc.Ctx, rel)
+}
+
+func (s *rowHistoryState) resolveToTS(tf *TableFunction, proc *process.Process, nthRow int) (types.TS, error) {
+	if len(tf.ctr.argVecs) > 3 && !tf.ctr.argVecs[3].IsConstNull() {
+		physical, _, err := getInt64Value(proc, tf.ctr.argVecs[3], nthRow, false, "to_ts")
+		if err != nil {
+			return types.TS{}, err
+		}
+		return types.BuildTS(physical, 0), nil
+	}
+	return types.TimestampToTS(proc.GetTxnOperator().Txn().SnapshotTS), nil
+}
+
+func (s *rowHistoryState) collectDataVersions(tf *TableFunction, proc *process.Process, data *batch.Batch, pkName, pkValue string) error {
+	pkIdx := -1
+	for i, attr := range data.Attrs {
+		if attr == pkName {
+			pkIdx = i
+			break
+		}
+	}
+	if pkIdx < 0 {
+		return moerr.NewInternalErrorf(proc.Ctx, "row_history: primary key column %q not found in change data", pkName)
+	}
+	commitIdx := len(data.Attrs) - 1
+
+	rowCount := data.RowCount()
+	for row := 0; row < rowCount; row++ {
+		if fmt.Sprintf("%v", vector.GetAny(data.Vecs[pkIdx], row, false)) != pkValue {
+			continue
+		}
+		rowJSON, err := rowToJSON(data, row)
+		if err != nil {
+			return err
+		}
+		commitTS := vector.GetAny(data.Vecs[commitIdx], row, false).(types.TS)
+		if err = s.appendRow(tf, proc, commitTS, "upsert", rowJSON); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *rowHistoryState) collectTombstoneVersions(tf *TableFunction, proc *process.Process, tombstone *batch.Batch, pkValue string) error {
+	pkIdx := -1
+	commitIdx := -1
+	for i, attr := range tombstone.Attrs {
+		switch attr {
+		case objectio.TombstoneAttr_PK_Attr:
+			pkIdx = i
+		case objectio.DefaultCommitTS_Attr:
+			commitIdx = i
+		}
+	}
+	if pkIdx < 0 || commitIdx < 0 {
+		return moerr.NewInternalErrorNoCtx("row_history: unexpected tombstone batch layout")
+	}
+
+	rowCount := tombstone.RowCount()
+	for row := 0; row < rowCount; row++ {
+		if fmt.Sprintf("%v", vector.GetAny(tombstone.Vecs[pkIdx], row, false)) != pkValue {
+			continue
+		}
+		commitTS := vector.GetAny(tombstone.Vecs[commitIdx], row, false).(types.TS)
+		if err := s.appendRow(tf, proc, commitTS, "delete", "{}"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowToJSON stringifies every column of a matched row into a JSON object,
+// since row_history's output schema can't be bound to the target table's
+// actual (runtime-only-known) column types.
+func rowToJSON(data *batch.Batch, row int) (string, error) {
+	values := make(map[string]any, len(data.Attrs)-1)
+	for i, attr := range data.Attrs[:len(data.Attrs)-1] {
+		v := vector.GetAny(data.Vecs[i], row, false)
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+		values[attr] = v
+	}
+	out, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func (s *rowHistoryState) appendRow(tf *TableFunction, proc *process.Process, commitTS types.TS, opType, rowJSON string) error {
+	mp := proc.GetMPool()
+	for i, colName := range tf.Attrs {
+		var err error
+		switch colName {
+		case "COMMIT_TS":
+			err = vector.AppendFixed(s.batch.Vecs[i], commitTS, false, mp)
+		case "OP_TYPE":
+			err = vector.AppendBytes(s.batch.Vecs[i], []byte(opType), false, mp)
+		case "ROW_JSON":
+			err = vector.AppendBytes(s.batch.Vecs[i], []byte(rowJSON), false, mp)
+		default:
+			err = moerr.NewInternalErrorf(proc.Ctx, "row_history: unknown column name %q", colName)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	s.batch.AddRowCount(1)
+	return nil
+}
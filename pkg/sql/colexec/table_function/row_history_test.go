@@ -0,0 +1,126 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func newRowHistoryBatch(t *testing.T) *batch.Batch {
+	mp := testutil.NewProc(t).GetMPool()
+
+	bat := batch.NewWithSize(3)
+	bat.Attrs = []string{"id", "label", objectio.DefaultCommitTS_Attr}
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_TS.ToType())
+
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(1), false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte("cat"), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.BuildTS(100, 0), false, mp))
+
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(2), false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte("dog"), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.BuildTS(200, 0), false, mp))
+
+	bat.SetRowCount(2)
+	return bat
+}
+
+func newRowHistoryTombstoneBatch(t *testing.T) *batch.Batch {
+	mp := testutil.NewProc(t).GetMPool()
+
+	bat := batch.NewWithSize(2)
+	bat.Attrs = []string{objectio.TombstoneAttr_PK_Attr, objectio.DefaultCommitTS_Attr}
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(1), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.BuildTS(300, 0), false, mp))
+
+	bat.SetRowCount(1)
+	return bat
+}
+
+func newRowHistoryState(t *testing.T) (*rowHistoryState, *TableFunction) {
+	proc := testutil.NewProc(t)
+	tf := &TableFunction{
+		Attrs: []string{"COMMIT_TS", "OP_TYPE", "ROW_JSON"},
+		Rets: []*plan.ColDef{
+			{Typ: plan.Type{Id: int32(types.T_TS)}},
+			{Typ: plan.Type{Id: int32(types.T_varchar), Width: 256}},
+			{Typ: plan.Type{Id: int32(types.T_text)}},
+		},
+		FuncName: "row_history",
+		OperatorBase: vm.OperatorBase{
+			OperatorInfo: vm.OperatorInfo{Idx: 0, IsFirst: false, IsLast: false},
+		},
+	}
+	require.NoError(t, tf.Prepare(proc))
+	s := tf.ctr.state.(*rowHistoryState)
+	s.startPreamble(tf, proc, 0)
+	return s, tf
+}
+
+func TestRowToJSON(t *testing.T) {
+	bat := newRowHistoryBatch(t)
+	defer bat.Clean(testutil.NewProc(t).GetMPool())
+
+	js, err := rowToJSON(bat, 1)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":2,"label":"dog"}`, js)
+}
+
+func TestRowHistoryCollectDataVersions(t *testing.T) {
+	s, tf := newRowHistoryState(t)
+	proc := testutil.NewProc(t)
+	bat := newRowHistoryBatch(t)
+	defer bat.Clean(proc.GetMPool())
+
+	require.NoError(t, s.collectDataVersions(tf, proc, bat, "id", "2"))
+	require.Equal(t, 1, s.batch.RowCount())
+	require.Equal(t, "upsert", s.batch.Vecs[1].GetStringAt(0))
+	require.JSONEq(t, `{"id":2,"label":"dog"}`, s.batch.Vecs[2].GetStringAt(0))
+}
+
+func TestRowHistoryCollectTombstoneVersions(t *testing.T) {
+	s, tf := newRowHistoryState(t)
+	proc := testutil.NewProc(t)
+	bat := newRowHistoryTombstoneBatch(t)
+	defer bat.Clean(proc.GetMPool())
+
+	require.NoError(t, s.collectTombstoneVersions(tf, proc, bat, "1"))
+	require.Equal(t, 1, s.batch.RowCount())
+	require.Equal(t, "delete", s.batch.Vecs[1].GetStringAt(0))
+}
+
+func TestRowHistoryCollectDataVersionsNoMatch(t *testing.T) {
+	s, tf := newRowHistoryState(t)
+	proc := testutil.NewProc(t)
+	bat := newRowHistoryBatch(t)
+	defer bat.Clean(proc.GetMPool())
+
+	require.NoError(t, s.collectDataVersions(tf, proc, bat, "id", "999"))
+	require.Equal(t, 0, s.batch.RowCount())
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteIdent(t *testing.T) {
+	require.Equal(t, "`col`", quoteIdent("col"))
+	require.Equal(t, "`a``b`", quoteIdent("a`b"))
+}
+
+func TestVectorAsFloat64(t *testing.T) {
+	mp := testutil.NewProc(t).GetMPool()
+
+	vec := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixed(vec, int64(42), false, mp))
+	require.NoError(t, vector.AppendFixed(vec, int64(0), true, mp))
+	defer vec.Free(mp)
+
+	f, ok := vectorAsFloat64(vec, 0)
+	require.True(t, ok)
+	require.Equal(t, float64(42), f)
+
+	_, ok = vectorAsFloat64(vec, 1)
+	require.False(t, ok)
+}
+
+func newStatsDriftState(t *testing.T) (*statsDriftState, *TableFunction) {
+	proc := testutil.NewProc(t)
+	tf := &TableFunction{
+		Attrs: []string{"COLUMN_NAME", "NULL_RATIO_A", "NULL_RATIO_B", "NULL_RATIO_DRIFT", "NDV_A", "NDV_B", "NDV_DRIFT", "HISTOGRAM_DISTANCE"},
+		Rets: []*plan.ColDef{
+			{Typ: plan.Type{Id: int32(types.T_varchar), Width: 256}},
+			{Typ: plan.Type{Id: int32(types.T_float64)}},
+			{Typ: plan.Type{Id: int32(types.T_float64)}},
+			{Typ: plan.Type{Id: int32(types.T_float64)}},
+			{Typ: plan.Type{Id: int32(types.T_uint64)}},
+			{Typ: plan.Type{Id: int32(types.T_uint64)}},
+			{Typ: plan.Type{Id: int32(types.T_float64)}},
+			{Typ: plan.Type{Id: int32(types.T_float64)}},
+		},
+		FuncName: "stats_drift",
+		OperatorBase: vm.OperatorBase{
+			OperatorInfo: vm.OperatorInfo{Idx: 0, IsFirst: false, IsLast: false},
+		},
+	}
+	require.NoError(t, tf.Prepare(proc))
+	s := tf.ctr.state.(*statsDriftState)
+	s.startPreamble(tf, proc, 0)
+	return s, tf
+}
+
+func TestStatsDriftAppendRowNumeric(t *testing.T) {
+	s, tf := newStatsDriftState(t)
+	proc := testutil.NewProc(t)
+
+	a := statsDriftColumnStats{nullRatio: 0.1, ndv: 100, numeric: true, min: 0, max: 100, avg: 50, std: 10}
+	b := statsDriftColumnStats{nullRatio: 0.3, ndv: 150, numeric: true, min: 0, max: 100, avg: 60, std: 20}
+
+	require.NoError(t, s.appendRow(tf, proc, "age", a, b))
+	require.Equal(t, 1, s.batch.RowCount())
+	require.Equal(t, "age", s.batch.Vecs[0].GetStringAt(0))
+	require.InDelta(t, 0.2, vector.MustFixedColWithTypeCheck[float64](s.batch.Vecs[3])[0], 1e-9)
+	require.False(t, s.batch.Vecs[7].IsNull(0))
+}
+
+func TestStatsDriftAppendRowNonNumeric(t *testing.T) {
+	s, tf := newStatsDriftState(t)
+	proc := testutil.NewProc(t)
+
+	a := statsDriftColumnStats{nullRatio: 0, ndv: 5}
+	b := statsDriftColumnStats{nullRatio: 0, ndv: 5}
+
+	require.NoError(t, s.appendRow(tf, proc, "name", a, b))
+	require.True(t, s.batch.Vecs[7].IsNull(0))
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func newTableDiffDataBatch(t *testing.T, ids []int64, labels []string) *batch.Batch {
+	return newTableDiffDataBatchAt(t, ids, labels, nil)
+}
+
+// newTableDiffDataBatchAt is newTableDiffDataBatch with an explicit commit_ts
+// per row, for tests that need to control event ordering relative to
+// tombstones. A nil tss falls back to the (i+1)*100 default.
+func newTableDiffDataBatchAt(t *testing.T, ids []int64, labels []string, tss []int64) *batch.Batch {
+	mp := testutil.NewProc(t).GetMPool()
+
+	bat := batch.NewWithSize(3)
+	bat.Attrs = []string{"id", "label", objectio.DefaultCommitTS_Attr}
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_TS.ToType())
+
+	for i, id := range ids {
+		ts := int64(i+1) * 100
+		if tss != nil {
+			ts = tss[i]
+		}
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], id, false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte(labels[i]), false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.BuildTS(ts, 0), false, mp))
+	}
+	bat.SetRowCount(len(ids))
+	return bat
+}
+
+func newTableDiffTombstoneBatch(t *testing.T, ids []int64) *batch.Batch {
+	return newTableDiffTombstoneBatchAt(t, ids, nil)
+}
+
+// newTableDiffTombstoneBatchAt is newTableDiffTombstoneBatch with an explicit
+// commit_ts per row; see newTableDiffDataBatchAt.
+func newTableDiffTombstoneBatchAt(t *testing.T, ids []int64, tss []int64) *batch.Batch {
+	mp := testutil.NewProc(t).GetMPool()
+
+	bat := batch.NewWithSize(2)
+	bat.Attrs = []string{objectio.TombstoneAttr_PK_Attr, objectio.DefaultCommitTS_Attr}
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+
+	for i, id := range ids {
+		ts := int64(i+1) * 100
+		if tss != nil {
+			ts = tss[i]
+		}
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], id, false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.BuildTS(ts, 0), false, mp))
+	}
+	bat.SetRowCount(len(ids))
+	return bat
+}
+
+func newTableDiffState(t *testing.T) (*tableDiffState, *TableFunction) {
+	proc := testutil.NewProc(t)
+	tf := &TableFunction{
+		Attrs: []string{"PK_VALUE", "CHANGE_TYPE", "ROW_JSON"},
+		Rets: []*plan.ColDef{
+			{Typ: plan.Type{Id: int32(types.T_text)}},
+			{Typ: plan.Type{Id: int32(types.T_varchar), Width: 256}},
+			{Typ: plan.Type{Id: int32(types.T_text)}},
+		},
+		FuncName: "table_diff",
+		OperatorBase: vm.OperatorBase{
+			OperatorInfo: vm.OperatorInfo{Idx: 0, IsFirst: false, IsLast: false},
+		},
+	}
+	require.NoError(t, tf.Prepare(proc))
+	s := tf.ctr.state.(*tableDiffState)
+	s.startPreamble(tf, proc, 0)
+	return s, tf
+}
+
+func rowsByPK(t *testing.T, s *tableDiffState) map[string][2]string {
+	out := make(map[string][2]string)
+	for row := 0; row < s.batch.RowCount(); row++ {
+		pk := s.batch.Vecs[0].GetStringAt(row)
+		ct := s.batch.Vecs[1].GetStringAt(row)
+		js := s.batch.Vecs[2].GetStringAt(row)
+		_, dup := out[pk]
+		require.False(t, dup, "duplicate pk %s in output", pk)
+		out[pk] = [2]string{ct, js}
+	}
+	return out
+}
+
+func TestTableDiffInsertOnly(t *testing.T) {
+	s, tf := newTableDiffState(t)
+	proc := testutil.NewProc(t)
+	data := newTableDiffDataBatch(t, []int64{1}, []string{"cat"})
+	defer data.Clean(proc.GetMPool())
+
+	rows := make(map[string]*tableDiffRow)
+	require.NoError(t, collectTableDiffData(data, "id", rows))
+	require.NoError(t, s.appendRows(tf, proc, rows))
+
+	got := rowsByPK(t, s)
+	require.Equal(t, "insert", got["1"][0])
+	require.JSONEq(t, `{"id":1,"label":"cat"}`, got["1"][1])
+}
+
+func TestTableDiffDeleteOnly(t *testing.T) {
+	s, tf := newTableDiffState(t)
+	proc := testutil.NewProc(t)
+	tombstone := newTableDiffTombstoneBatch(t, []int64{1})
+	defer tombstone.Clean(proc.GetMPool())
+
+	rows := make(map[string]*tableDiffRow)
+	require.NoError(t, collectTableDiffTombstones(tombstone, rows))
+	require.NoError(t, s.appendRows(tf, proc, rows))
+
+	got := rowsByPK(t, s)
+	require.Equal(t, "delete", got["1"][0])
+	require.JSONEq(t, `{}`, got["1"][1])
+}
+
+func TestTableDiffUpdateIsDeletePlusInsertOfSamePK(t *testing.T) {
+	s, tf := newTableDiffState(t)
+	proc := testutil.NewProc(t)
+	tombstone := newTableDiffTombstoneBatch(t, []int64{1})
+	defer tombstone.Clean(proc.GetMPool())
+	data := newTableDiffDataBatch(t, []int64{1}, []string{"dog"})
+	defer data.Clean(proc.GetMPool())
+
+	rows := make(map[string]*tableDiffRow)
+	require.NoError(t, collectTableDiffTombstones(tombstone, rows))
+	require.NoError(t, collectTableDiffData(data, "id", rows))
+	require.NoError(t, s.appendRows(tf, proc, rows))
+
+	got := rowsByPK(t, s)
+	require.Equal(t, "update", got["1"][0])
+	require.JSONEq(t, `{"id":1,"label":"dog"}`, got["1"][1])
+}
+
+func TestTableDiffMixedChanges(t *testing.T) {
+	s, tf := newTableDiffState(t)
+	proc := testutil.NewProc(t)
+	data := newTableDiffDataBatch(t, []int64{1, 2}, []string{"cat", "fox"})
+	defer data.Clean(proc.GetMPool())
+	tombstone := newTableDiffTombstoneBatch(t, []int64{3})
+	defer tombstone.Clean(proc.GetMPool())
+
+	rows := make(map[string]*tableDiffRow)
+	require.NoError(t, collectTableDiffData(data, "id", rows))
+	require.NoError(t, collectTableDiffTombstones(tombstone, rows))
+	require.NoError(t, s.appendRows(tf, proc, rows))
+
+	got := rowsByPK(t, s)
+	require.Len(t, got, 3)
+	require.Equal(t, "insert", got["1"][0])
+	require.Equal(t, "insert", got["2"][0])
+	require.Equal(t, "delete", got["3"][0])
+}
+
+func TestTableDiffInsertThenDeleteSamePKHasNoNetEffect(t *testing.T) {
+	s, tf := newTableDiffState(t)
+	proc := testutil.NewProc(t)
+	data := newTableDiffDataBatchAt(t, []int64{1}, []string{"cat"}, []int64{100})
+	defer data.Clean(proc.GetMPool())
+	tombstone := newTableDiffTombstoneBatchAt(t, []int64{1}, []int64{200})
+	defer tombstone.Clean(proc.GetMPool())
+
+	rows := make(map[string]*tableDiffRow)
+	require.NoError(t, collectTableDiffData(data, "id", rows))
+	require.NoError(t, collectTableDiffTombstones(tombstone, rows))
+	require.NoError(t, s.appendRows(tf, proc, rows))
+
+	got := rowsByPK(t, s)
+	require.Empty(t, got, "a pk inserted then deleted within the window has no net effect")
+}
+
+func TestTableDiffDeleteThenReinsertSamePKIsUpdate(t *testing.T) {
+	s, tf := newTableDiffState(t)
+	proc := testutil.NewProc(t)
+	tombstone := newTableDiffTombstoneBatchAt(t, []int64{1}, []int64{100})
+	defer tombstone.Clean(proc.GetMPool())
+	data := newTableDiffDataBatchAt(t, []int64{1}, []string{"dog"}, []int64{200})
+	defer data.Clean(proc.GetMPool())
+
+	rows := make(map[string]*tableDiffRow)
+	require.NoError(t, collectTableDiffTombstones(tombstone, rows))
+	require.NoError(t, collectTableDiffData(data, "id", rows))
+	require.NoError(t, s.appendRows(tf, proc, rows))
+
+	got := rowsByPK(t, s)
+	require.Equal(t, "update", got["1"][0])
+	require.JSONEq(t, `{"id":1,"label":"dog"}`, got["1"][1])
+}
@@ -188,6 +188,12 @@ func (tableFunction *TableFunction) Prepare(proc *process.Process) error {
 		tblArg.ctr.state, err = parseJsonlFilePrepare(proc, tblArg)
 	case "table_stats":
 		tblArg.ctr.state, err = tableStatsPrepare(proc, tblArg)
+	case "row_history":
+		tblArg.ctr.state, err = rowHistoryPrepare(proc, tblArg)
+	case "table_diff":
+		tblArg.ctr.state, err = tableDiffPrepare(proc, tblArg)
+	case "stats_drift":
+		tblArg.ctr.state, err = statsDriftPrepare(proc, tblArg)
 	case "load_file_chunks":
 		tblArg.ctr.state, err = loadFileChunksPrepare(proc, tblArg)
 	default:
@@ -296,6 +296,7 @@ func (c *Compile) fillPlanNodeAnalyzeInfo(stats *statistic.StatsInfo) {
 func ConvertScopeToPhyScope(scope *Scope, receiverMap map[*process.WaitRegister]int) models.PhyScope {
 	phyScope := models.PhyScope{
 		Magic:        scope.Magic.String(),
+		CnAddr:       scope.NodeInfo.Addr,
 		Mcpu:         int8(scope.NodeInfo.Mcpu),
 		DataSource:   ConvertSourceToPhySource(scope.DataSource),
 		PreScopes:    []models.PhyScope{},
@@ -372,6 +373,7 @@ func ConvertOperatorToPhyOperator(op vm.Operator, rmp map[*process.WaitRegister]
 		DestReceiver: getDestReceiver(op, rmp),
 		IsFirst:      op.GetOperatorBase().IsFirst,
 		IsLast:       op.GetOperatorBase().IsLast,
+		ExchangeType: dispatchExchangeType(op),
 	}
 
 	if op.GetOperatorBase().IsFirst {
@@ -395,6 +397,26 @@ func ConvertOperatorToPhyOperator(op vm.Operator, rmp map[*process.WaitRegister]
 	return phyOp
 }
 
+// dispatchExchangeType reports how a Dispatch operator fans its batches out to
+// its receivers, so EXPLAIN ANALYZE's physical plan can tell a broadcast exchange
+// from a shuffle one. Returns "" for every operator that isn't a Dispatch.
+func dispatchExchangeType(op vm.Operator) string {
+	dop, ok := op.(*dispatch.Dispatch)
+	if !ok {
+		return ""
+	}
+	switch dop.FuncId {
+	case dispatch.SendToAllFunc, dispatch.SendToAllLocalFunc:
+		return "broadcast"
+	case dispatch.ShuffleToAllFunc:
+		return "shuffle"
+	case dispatch.SendToAnyFunc, dispatch.SendToAnyLocalFunc:
+		return "any"
+	default:
+		return ""
+	}
+}
+
 func UpdatePreparePhyOperator(op vm.Operator, phyOp *models.PhyOperator) bool {
 	if op == nil {
 		return true
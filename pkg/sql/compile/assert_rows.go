@@ -0,0 +1,75 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// checkRowsAssertion validates an optional "assert rows ..." clause on a
+// DELETE/UPDATE statement against the number of rows the statement actually
+// affected. It runs once, after all scopes (including any remote ones) have
+// already finished and been aggregated into affectedRows, so a violation
+// surfaces as a normal statement error and triggers the same rollback path
+// as any other runtime failure - no extra transaction-abort plumbing needed.
+func checkRowsAssertion(stmt tree.Statement, affectedRows uint64) error {
+	var assertion *tree.RowsAssertion
+	switch s := stmt.(type) {
+	case *tree.Delete:
+		assertion = s.RowsAssertion
+	case *tree.Update:
+		assertion = s.RowsAssertion
+	default:
+		return nil
+	}
+	if assertion == nil {
+		return nil
+	}
+
+	rows := int64(affectedRows)
+	if assertion.Between {
+		if rows < assertion.Lo || rows > assertion.Hi {
+			return moerr.NewInternalErrorNoCtxf(
+				"statement affected %d rows, which violates assertion: rows between %d and %d",
+				affectedRows, assertion.Lo, assertion.Hi)
+		}
+		return nil
+	}
+
+	ok := false
+	switch assertion.Op {
+	case tree.EQUAL:
+		ok = rows == assertion.Count
+	case tree.LESS_THAN:
+		ok = rows < assertion.Count
+	case tree.LESS_THAN_EQUAL:
+		ok = rows <= assertion.Count
+	case tree.GREAT_THAN:
+		ok = rows > assertion.Count
+	case tree.GREAT_THAN_EQUAL:
+		ok = rows >= assertion.Count
+	case tree.NOT_EQUAL:
+		ok = rows != assertion.Count
+	default:
+		return moerr.NewInternalErrorNoCtxf("unsupported rows assertion operator: %s", assertion.Op.ToString())
+	}
+	if !ok {
+		return moerr.NewInternalErrorNoCtxf(
+			"statement affected %d rows, which violates assertion: rows %s %d",
+			affectedRows, assertion.Op.ToString(), assertion.Count)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRowsAssertion(t *testing.T) {
+	tests := []struct {
+		name         string
+		assertion    *tree.RowsAssertion
+		affectedRows uint64
+		wantErr      bool
+	}{
+		{name: "no assertion", assertion: nil, affectedRows: 5, wantErr: false},
+		{name: "EQUAL satisfied", assertion: tree.NewRowsAssertion(tree.EQUAL, 5), affectedRows: 5, wantErr: false},
+		{name: "EQUAL violated", assertion: tree.NewRowsAssertion(tree.EQUAL, 5), affectedRows: 4, wantErr: true},
+		{name: "LESS_THAN satisfied", assertion: tree.NewRowsAssertion(tree.LESS_THAN, 5), affectedRows: 4, wantErr: false},
+		{name: "LESS_THAN boundary violated", assertion: tree.NewRowsAssertion(tree.LESS_THAN, 5), affectedRows: 5, wantErr: true},
+		{name: "LESS_THAN_EQUAL boundary satisfied", assertion: tree.NewRowsAssertion(tree.LESS_THAN_EQUAL, 5), affectedRows: 5, wantErr: false},
+		{name: "LESS_THAN_EQUAL violated", assertion: tree.NewRowsAssertion(tree.LESS_THAN_EQUAL, 5), affectedRows: 6, wantErr: true},
+		{name: "GREAT_THAN satisfied", assertion: tree.NewRowsAssertion(tree.GREAT_THAN, 5), affectedRows: 6, wantErr: false},
+		{name: "GREAT_THAN boundary violated", assertion: tree.NewRowsAssertion(tree.GREAT_THAN, 5), affectedRows: 5, wantErr: true},
+		{name: "GREAT_THAN_EQUAL boundary satisfied", assertion: tree.NewRowsAssertion(tree.GREAT_THAN_EQUAL, 5), affectedRows: 5, wantErr: false},
+		{name: "GREAT_THAN_EQUAL violated", assertion: tree.NewRowsAssertion(tree.GREAT_THAN_EQUAL, 5), affectedRows: 4, wantErr: true},
+		{name: "NOT_EQUAL satisfied", assertion: tree.NewRowsAssertion(tree.NOT_EQUAL, 5), affectedRows: 4, wantErr: false},
+		{name: "NOT_EQUAL violated", assertion: tree.NewRowsAssertion(tree.NOT_EQUAL, 5), affectedRows: 5, wantErr: true},
+		{name: "BETWEEN satisfied", assertion: tree.NewRowsAssertionBetween(2, 5), affectedRows: 3, wantErr: false},
+		{name: "BETWEEN lower boundary satisfied", assertion: tree.NewRowsAssertionBetween(2, 5), affectedRows: 2, wantErr: false},
+		{name: "BETWEEN upper boundary satisfied", assertion: tree.NewRowsAssertionBetween(2, 5), affectedRows: 5, wantErr: false},
+		{name: "BETWEEN below range violated", assertion: tree.NewRowsAssertionBetween(2, 5), affectedRows: 1, wantErr: true},
+		{name: "BETWEEN above range violated", assertion: tree.NewRowsAssertionBetween(2, 5), affectedRows: 6, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			del := &tree.Delete{RowsAssertion: tt.assertion}
+			err := checkRowsAssertion(del, tt.affectedRows)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			upd := &tree.Update{RowsAssertion: tt.assertion}
+			err = checkRowsAssertion(upd, tt.affectedRows)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckRowsAssertion_UnsupportedStatement(t *testing.T) {
+	require.NoError(t, checkRowsAssertion(&tree.Select{}, 100))
+}
+
+func TestCheckRowsAssertion_UnsupportedOperator(t *testing.T) {
+	assertion := tree.NewRowsAssertion(tree.ComparisonOp(255), 5)
+	err := checkRowsAssertion(&tree.Delete{RowsAssertion: assertion}, 5)
+	require.Error(t, err)
+}
@@ -100,6 +100,13 @@ const (
 	shuffleChannelBufferSize        = 32
 
 	NoAccountId = -1
+
+	// BroadcastBuildOnceThreshold is the estimated build-side size, in
+	// bytes, under which a multi-CN broadcast join builds its hash table
+	// once (deduplicated per target CN) instead of once per probe scope.
+	// Below this threshold the extra hash-table builds cost more than the
+	// CN-to-CN fan-out they would otherwise avoid.
+	BroadcastBuildOnceThreshold uint64 = 10 * mpool.MB
 )
 
 var (
@@ -2386,7 +2393,7 @@ func (c *Compile) compileJoin(node, left, right *plan.Node, probeScopes, buildSc
 	}
 
 	rs := c.compileProbeSideForBroadcastJoin(node, left, right, probeScopes)
-	return c.compileBuildSideForBroadcastJoin(node, rs, buildScopes)
+	return c.compileBuildSideForBroadcastJoin(node, right, rs, buildScopes)
 }
 
 func (c *Compile) compileShuffleJoinV2(node, left, right *plan.Node, leftscopes, rightscopes []*Scope) []*Scope {
@@ -2623,7 +2630,19 @@ func (c *Compile) compileProbeSideForBroadcastJoin(node, left, right *plan.Node,
 	return rs
 }
 
-func (c *Compile) compileBuildSideForBroadcastJoin(node *plan.Node, rs, buildScopes []*Scope) []*Scope {
+// shouldBuildJoinMapOnce reports whether the broadcast join build side is
+// small enough that building one hash table per target CN (deduplicated by
+// CN address) is cheaper than building one per probe scope: every extra
+// build redoes the same hashing work the dispatch already paid once to
+// ship the rows there. Larger build sides are left on the existing
+// per-scope path, where the extra build parallelism matters more than the
+// duplicated hashing cost.
+func (c *Compile) shouldBuildJoinMapOnce(buildSide *plan.Node) bool {
+	estimatedSize := uint64(buildSide.Stats.Cost * buildSide.Stats.Rowsize)
+	return estimatedSize <= BroadcastBuildOnceThreshold
+}
+
+func (c *Compile) compileBuildSideForBroadcastJoin(node, buildSide *plan.Node, rs, buildScopes []*Scope) []*Scope {
 	if !c.IsSingleScope(buildScopes) { // first merge scopes of build side, will optimize this in the future
 		buildScopes = c.mergeShuffleScopesIfNeeded(buildScopes, false)
 		buildScopes = []*Scope{c.newMergeScope(buildScopes)}
@@ -2674,20 +2693,46 @@ func (c *Compile) compileBuildSideForBroadcastJoin(node *plan.Node, rs, buildSco
 
 	//broadcast join on multi CN
 
-	for i := range rs {
-		bs := newScope(Remote)
-		bs.NodeInfo = engine.Node{Addr: rs[i].NodeInfo.Addr, Mcpu: 1}
-		bs.Proc = c.proc.NewNoContextChildProc(0)
-		w := &process.WaitRegister{Ch2: make(chan process.PipelineSignal, 10)}
-		bs.Proc.Reg.MergeReceivers = append(bs.Proc.Reg.MergeReceivers, w)
+	if c.shouldBuildJoinMapOnce(buildSide) {
+		v2.BroadcastJoinBuildOnceCounter.Inc()
+		seenAddr := make(map[string]*Scope, len(rs))
+		for i := range rs {
+			if bs, ok := seenAddr[rs[i].NodeInfo.Addr]; ok {
+				rs[i].PreScopes = append(rs[i].PreScopes, bs)
+				continue
+			}
+			bs := newScope(Remote)
+			bs.NodeInfo = engine.Node{Addr: rs[i].NodeInfo.Addr, Mcpu: 1}
+			bs.Proc = c.proc.NewNoContextChildProc(0)
+			w := &process.WaitRegister{Ch2: make(chan process.PipelineSignal, 10)}
+			bs.Proc.Reg.MergeReceivers = append(bs.Proc.Reg.MergeReceivers, w)
 
-		mergeOp := merge.NewArgument()
-		c.hasMergeOp = true
-		mergeOp.SetAnalyzeControl(c.anal.curNodeIdx, false)
-		bs.setRootOperator(mergeOp)
-		bs.setRootOperator(constructJoinBuildOperator(c, rs[i].RootOp, int32(rs[i].NodeInfo.Mcpu)))
-		rs[i].PreScopes = append(rs[i].PreScopes, bs)
-		buildOpScopes = append(buildOpScopes, bs)
+			mergeOp := merge.NewArgument()
+			c.hasMergeOp = true
+			mergeOp.SetAnalyzeControl(c.anal.curNodeIdx, false)
+			bs.setRootOperator(mergeOp)
+			bs.setRootOperator(constructJoinBuildOperator(c, rs[i].RootOp, int32(rs[i].NodeInfo.Mcpu)))
+			rs[i].PreScopes = append(rs[i].PreScopes, bs)
+			buildOpScopes = append(buildOpScopes, bs)
+			seenAddr[rs[i].NodeInfo.Addr] = bs
+		}
+	} else {
+		v2.BroadcastJoinBuildPerScopeCounter.Inc()
+		for i := range rs {
+			bs := newScope(Remote)
+			bs.NodeInfo = engine.Node{Addr: rs[i].NodeInfo.Addr, Mcpu: 1}
+			bs.Proc = c.proc.NewNoContextChildProc(0)
+			w := &process.WaitRegister{Ch2: make(chan process.PipelineSignal, 10)}
+			bs.Proc.Reg.MergeReceivers = append(bs.Proc.Reg.MergeReceivers, w)
+
+			mergeOp := merge.NewArgument()
+			c.hasMergeOp = true
+			mergeOp.SetAnalyzeControl(c.anal.curNodeIdx, false)
+			bs.setRootOperator(mergeOp)
+			bs.setRootOperator(constructJoinBuildOperator(c, rs[i].RootOp, int32(rs[i].NodeInfo.Mcpu)))
+			rs[i].PreScopes = append(rs[i].PreScopes, bs)
+			buildOpScopes = append(buildOpScopes, bs)
+		}
 	}
 
 	dispatchArg := constructDispatch(0, buildOpScopes, buildScopes[0], node, false)
@@ -326,6 +326,9 @@ func (c *Compile) Run(_ uint64) (queryResult *util2.RunResult, err error) {
 		return nil, err
 	}
 	queryResult.AffectRows = runC.getAffectedRows()
+	if err = checkRowsAssertion(c.stmt, queryResult.AffectRows); err != nil {
+		return queryResult, err
+	}
 	if c.uid != "mo_logger" &&
 		strings.Contains(strings.ToLower(c.sql), "insert") &&
 		(strings.Contains(c.sql, "{MO_TS =") ||
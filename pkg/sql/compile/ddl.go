@@ -55,6 +55,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/ctl"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
@@ -1759,6 +1760,10 @@ func (s *Scope) CreateTable(c *Compile) error {
 		res.Close()
 	}
 
+	if !isTemp {
+		ctl.PrimeCatalogCacheForNewTable(c.proc, dbName, tblName)
+	}
+
 	return nil
 }
 
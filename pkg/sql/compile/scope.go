@@ -675,7 +675,7 @@ func (s *Scope) waitForRuntimeFilters(c *Compile) ([]*plan.Expr, bool, error) {
 					continue
 				case message.RuntimeFilter_DROP:
 					return nil, true, nil
-				case message.RuntimeFilter_IN:
+				case message.RuntimeFilter_IN, message.RuntimeFilter_BLOOMFILTER:
 					inExpr := plan2.MakeInExpr(c.proc.Ctx, spec.Expr, msg.Card, msg.Data, spec.MatchPrefix)
 					runtimeInExprList = append(runtimeInExprList, inExpr)
 
@@ -29,6 +29,7 @@ type PhyPlan struct {
 
 type PhyScope struct {
 	Magic               string        `json:"Magic"`
+	CnAddr              string        `json:"CnAddr,omitempty"`
 	Mcpu                int8          `json:"Mcpu,omitempty"`
 	Receiver            []PhyReceiver `json:"Receiver,omitempty"`
 	DataSource          *PhySource    `json:"DataSource,omitempty"`
@@ -57,6 +58,9 @@ type PhyOperator struct {
 	Children     []*PhyOperator         `json:"Children,omitempty"`
 	IsFirst      bool                   `json:"IsFirst,omitempty"`
 	IsLast       bool                   `json:"IsLast,omitempty"`
+	// ExchangeType describes how a Dispatch operator fans its batches out
+	// (e.g. "broadcast", "shuffle"); empty for every non-exchange operator.
+	ExchangeType string `json:"ExchangeType,omitempty"`
 }
 
 func NewPhyPlan() *PhyPlan {
@@ -26,6 +26,7 @@ func init() {
 		"action":                     ACTION,
 		"against":                    AGAINST,
 		"all":                        ALL,
+		"always":                     ALWAYS,
 		"alter":                      ALTER,
 		"algorithm":                  ALGORITHM,
 		"analyze":                    ANALYZE,
@@ -34,6 +35,7 @@ func init() {
 		"any":                        ANY,
 		"as":                         AS,
 		"asc":                        ASC,
+		"assert":                     ASSERT,
 		"ascii":                      ASCII,
 		"asensitive":                 UNUSED,
 		"async":                      ASYNC,
@@ -211,7 +213,7 @@ func init() {
 		"file":                       FILE,
 		"files":                      FILES,
 		"fixed":                      FIXED,
-		"generated":                  UNUSED,
+		"generated":                  GENERATED,
 		"geometry":                   GEOMETRY,
 		"geometrycollection":         GEOMETRYCOLLECTION,
 		"get":                        UNUSED,
@@ -225,6 +227,7 @@ func init() {
 		"having":                     HAVING,
 		"hash":                       HASH,
 		"high_priority":              HIGH_PRIORITY,
+		"hold":                       HOLD,
 		"hour":                       HOUR,
 		"id":                         ID,
 		"identified":                 IDENTIFIED,
@@ -269,6 +272,7 @@ func init() {
 		"last":                       LAST,
 		"leading":                    LEADING,
 		"leave":                      LEAVE,
+		"legal":                      LEGAL,
 		"left":                       LEFT,
 		"less":                       LESS,
 		"level":                      LEVEL,
@@ -460,7 +464,7 @@ func init() {
 		"stats_auto_recalc":          STATS_AUTO_RECALC,
 		"stats_persistent":           STATS_PERSISTENT,
 		"stats_sample_pages":         STATS_SAMPLE_PAGES,
-		"stored":                     UNUSED,
+		"stored":                     STORED,
 		"storage":                    STORAGE,
 		"stores":                     STORES,
 		"straight_join":              STRAIGHT_JOIN,
@@ -513,7 +517,7 @@ func init() {
 		"varchar":                    VARCHAR,
 		"varcharacter":               UNUSED,
 		"varying":                    UNUSED,
-		"virtual":                    UNUSED,
+		"virtual":                    VIRTUAL,
 		"view":                       VIEW,
 		"visible":                    VISIBLE,
 		"week":                       WEEK,
@@ -679,5 +683,7 @@ func init() {
 		"diff":                       DIFF,
 		"conflict":                   CONFLICT,
 		"output":                     OUTPUT,
+		"exchange":                   EXCHANGE,
+		"verify":                     VERIFY,
 	}
 }
@@ -1629,6 +1629,12 @@ var (
 			input: "delete from t where a > 1 order by b limit 1 offset 2",
 		}, {
 			input: "delete from t where a = 1",
+		}, {
+			input: "delete from t where a = 1 assert rows <= 1000",
+		}, {
+			input: "delete from t where a = 1 assert rows between 1 and 100",
+		}, {
+			input: "update t set a = 1 where b = 2 assert rows = 1",
 		}, {
 			input: "insert into u partition(p1, p2) (a, b, c, d) values (1, 2, 3, 4), (5, 6, 1, 0)",
 		}, {
@@ -2451,6 +2457,16 @@ var (
 			input:  "alter table t1 alter index c invisible",
 			output: "alter table t1 alter index c invisible",
 		},
+		{
+			input:  "alter table t1 set retention 90 'day'",
+			output: "alter table t1 set retention 90 day",
+		},
+		{
+			input: "alter table t1 set legal hold",
+		},
+		{
+			input: "alter table t1 set legal hold release",
+		},
 		{
 			input:  "alter table t1 add constraint uk_6dotkott2kjsp8vw4d0m25fb7 unique key (col3)",
 			output: "alter table t1 add constraint uk_6dotkott2kjsp8vw4d0m25fb7 unique key (col3)",
@@ -2691,6 +2707,17 @@ var (
 			input:  "create snapshot snapshot_01 for table db1 t1",
 			output: "create snapshot snapshot_01 for table db1.t1",
 		},
+		{
+			input:  "create snapshot snapshot_01 for table db1 t1 {MO_TS = 123456}",
+			output: "create snapshot snapshot_01 for table db1.t1{mo-timestamp = 123456}",
+		},
+		{
+			input:  "create snapshot snapshot_01 comment 'before release' for cluster",
+			output: "create snapshot snapshot_01 comment 'before release' for cluster",
+		},
+		{
+			input: "show snapshots where sname like 'snapshot_%' order by ts desc",
+		},
 		{
 			input:  "select * from t1 {as of timestamp '2019-01-01 00:00:00'}",
 			output: "select * from t1{as of timestamp 2019-01-01 00:00:00}",
@@ -2723,6 +2750,10 @@ var (
 			input:  "restore table account_01.db1.t1{snapshot=\"snapshot_01\"}",
 			output: "restore table account_01.db1.t1{snapshot=snapshot_01}",
 		},
+		{
+			input:  "restore table account_01.db1.t1{snapshot=\"snapshot_01\"} as t1_snap",
+			output: "restore table account_01.db1.t1{snapshot=snapshot_01} as t1_snap",
+		},
 		{
 			input:  "restore account account_01{snapshot=\"snapshot_01\"} to account account_02",
 			output: "restore account account_01{snapshot=snapshot_01} to account account_02",
@@ -75,6 +75,18 @@ func init() {
 		reuse.DefaultOptions[AlterOptionAlterCheck](), //.
 	) // WithEnableChecker()
 
+	reuse.CreatePool[AlterTableRetention](
+		func() *AlterTableRetention { return &AlterTableRetention{} },
+		func(a *AlterTableRetention) { a.reset() },
+		reuse.DefaultOptions[AlterTableRetention](), //.
+	) // WithEnableChecker()
+
+	reuse.CreatePool[AlterTableLegalHold](
+		func() *AlterTableLegalHold { return &AlterTableLegalHold{} },
+		func(a *AlterTableLegalHold) { a.reset() },
+		reuse.DefaultOptions[AlterTableLegalHold](), //.
+	) // WithEnableChecker()
+
 	reuse.CreatePool[AlterOptionAlterAutoUpdate](
 		func() *AlterOptionAlterAutoUpdate { return &AlterOptionAlterAutoUpdate{} },
 		func(a *AlterOptionAlterAutoUpdate) { a.reset() },
@@ -586,6 +598,10 @@ func (node *AlterTable) reset() {
 				opt.Free()
 			case *AlterOptionAlterCheck:
 				opt.Free()
+			case *AlterTableRetention:
+				opt.Free()
+			case *AlterTableLegalHold:
+				opt.Free()
 			case *AlterOptionAdd:
 				opt.Free()
 			case *AlterOptionDrop:
@@ -903,6 +919,65 @@ func (node *AlterOptionAlterCheck) reset() {
 	*node = AlterOptionAlterCheck{}
 }
 
+// AlterTableRetention declares a minimum data retention period for a table
+// (ALTER TABLE t SET RETENTION <value> <unit>). Value/Unit follow the same
+// convention as CreatePitr/AlterPitr's range clause.
+type AlterTableRetention struct {
+	alterOptionImpl
+	Value int64
+	Unit  string
+}
+
+func NewAlterTableRetention(value int64, unit string) *AlterTableRetention {
+	a := reuse.Alloc[AlterTableRetention](nil)
+	a.Value = value
+	a.Unit = unit
+	return a
+}
+
+func (node *AlterTableRetention) Free() { reuse.Free[AlterTableRetention](node, nil) }
+
+func (node *AlterTableRetention) Format(ctx *FmtCtx) {
+	ctx.WriteString("set retention ")
+	ctx.WriteString(fmt.Sprintf("%v ", node.Value))
+	ctx.WriteString(node.Unit)
+}
+
+func (node AlterTableRetention) TypeName() string { return "tree.AlterTableRetention" }
+
+func (node *AlterTableRetention) reset() {
+	*node = AlterTableRetention{}
+}
+
+// AlterTableLegalHold places or releases a legal hold on a table
+// (ALTER TABLE t SET LEGAL HOLD / ALTER TABLE t SET LEGAL HOLD RELEASE).
+type AlterTableLegalHold struct {
+	alterOptionImpl
+	Hold bool
+}
+
+func NewAlterTableLegalHold(hold bool) *AlterTableLegalHold {
+	a := reuse.Alloc[AlterTableLegalHold](nil)
+	a.Hold = hold
+	return a
+}
+
+func (node *AlterTableLegalHold) Free() { reuse.Free[AlterTableLegalHold](node, nil) }
+
+func (node *AlterTableLegalHold) Format(ctx *FmtCtx) {
+	if node.Hold {
+		ctx.WriteString("set legal hold")
+	} else {
+		ctx.WriteString("set legal hold release")
+	}
+}
+
+func (node AlterTableLegalHold) TypeName() string { return "tree.AlterTableLegalHold" }
+
+func (node *AlterTableLegalHold) reset() {
+	*node = AlterTableLegalHold{}
+}
+
 type AlterOptionAdd struct {
 	alterOptionImpl
 	Def TableDef
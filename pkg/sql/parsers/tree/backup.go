@@ -22,6 +22,12 @@ func init() {
 		func(b *BackupStart) { b.reset() },
 		reuse.DefaultOptions[BackupStart](), //.
 	) //WithEnableChecker()
+
+	reuse.CreatePool[VerifyBackup](
+		func() *VerifyBackup { return &VerifyBackup{} },
+		func(v *VerifyBackup) { v.reset() },
+		reuse.DefaultOptions[VerifyBackup](), //.
+	)
 }
 
 type BackupStart struct {
@@ -91,3 +97,36 @@ func (node *BackupStart) reset() {
 func (node *BackupStart) Free() {
 	reuse.Free[BackupStart](node, nil)
 }
+
+// VerifyBackup is 'verify backup <dir>': restore the backup found at Dir
+// into an ephemeral namespace, compare it against the backup's own
+// manifest, then drop the namespace again.
+type VerifyBackup struct {
+	statementImpl
+	Dir string
+}
+
+func (node *VerifyBackup) Format(ctx *FmtCtx) {
+	ctx.WriteString("verify backup ")
+	ctx.WriteString(node.Dir)
+}
+
+func NewVerifyBackup(dir string) *VerifyBackup {
+	verify := reuse.Alloc[VerifyBackup](nil)
+	verify.Dir = dir
+	return verify
+}
+
+func (node *VerifyBackup) GetStatementType() string { return "Verify Backup" }
+
+func (node *VerifyBackup) GetQueryType() string { return QueryTypeOth }
+
+func (node VerifyBackup) TypeName() string { return "tree.VerifyBackup" }
+
+func (node *VerifyBackup) reset() {
+	*node = VerifyBackup{}
+}
+
+func (node *VerifyBackup) Free() {
+	reuse.Free[VerifyBackup](node, nil)
+}
@@ -71,6 +71,16 @@ func init() {
 		reuse.DefaultOptions[DataBranchMerge](),
 	)
 
+	reuse.CreatePool[DataBranchExchange](
+		func() *DataBranchExchange {
+			return &DataBranchExchange{}
+		},
+		func(c *DataBranchExchange) {
+			c.reset()
+		},
+		reuse.DefaultOptions[DataBranchExchange](),
+	)
+
 }
 
 type DataBranchType int
@@ -394,3 +404,51 @@ func (s *DataBranchMerge) GetQueryType() string {
 func (s *DataBranchMerge) Free() {
 	reuse.Free[DataBranchMerge](s, nil)
 }
+
+// DataBranchExchange atomically swaps the catalog identity of two
+// same-schema tables -- e.g. promoting a validated branch so it becomes
+// the main table, while the displaced main is kept around, now named
+// after the old branch, as a rollback point.
+type DataBranchExchange struct {
+	statementImpl
+	TableName1 TableName
+	TableName2 TableName
+}
+
+func (s *DataBranchExchange) TypeName() string {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *DataBranchExchange) reset() {
+	*s = DataBranchExchange{}
+}
+
+func NewDataBranchExchange() *DataBranchExchange {
+	return reuse.Alloc[DataBranchExchange](nil)
+}
+
+func (s *DataBranchExchange) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (s *DataBranchExchange) Format(ctx *FmtCtx) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (s *DataBranchExchange) String() string {
+	return s.GetStatementType()
+}
+
+func (s *DataBranchExchange) GetStatementType() string {
+	return "branch exchange"
+}
+
+func (s *DataBranchExchange) GetQueryType() string {
+	return QueryTypeOth
+}
+
+func (s *DataBranchExchange) Free() {
+	reuse.Free[DataBranchExchange](s, nil)
+}
@@ -167,3 +167,28 @@ func TestDataBranchMergeLifecycle(t *testing.T) {
 
 	stmt.Free()
 }
+
+func TestDataBranchExchangeLifecycle(t *testing.T) {
+	stmt := NewDataBranchExchange()
+	require.NotNil(t, stmt)
+
+	require.Equal(t, frontendStatusTyp, stmt.StmtKind())
+	require.Equal(t, "branch exchange", stmt.GetStatementType())
+	require.Equal(t, "branch exchange", stmt.String())
+	require.Equal(t, QueryTypeOth, stmt.GetQueryType())
+
+	stmt.TableName1.ObjectName = Identifier("t1")
+	stmt.TableName2.ObjectName = Identifier("t2")
+	stmt.reset()
+	require.Equal(t, Identifier(""), stmt.TableName1.ObjectName)
+	require.Equal(t, Identifier(""), stmt.TableName2.ObjectName)
+
+	require.Panics(t, func() {
+		stmt.Format(nil)
+	})
+	require.Panics(t, func() {
+		stmt.TypeName()
+	})
+
+	stmt.Free()
+}
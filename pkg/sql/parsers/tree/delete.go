@@ -24,6 +24,7 @@ type Delete struct {
 	OrderBy        OrderBy
 	Limit          *Limit
 	With           *With
+	RowsAssertion  *RowsAssertion
 }
 
 func (node *Delete) Format(ctx *FmtCtx) {
@@ -63,6 +64,10 @@ func (node *Delete) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Limit.Format(ctx)
 	}
+	if node.RowsAssertion != nil {
+		ctx.WriteByte(' ')
+		node.RowsAssertion.Format(ctx)
+	}
 }
 
 func (node *Delete) GetStatementType() string { return "Delete" }
@@ -0,0 +1,56 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strconv"
+
+// RowsAssertion is an optional trailing clause on DELETE/UPDATE statements
+// that bounds the number of rows the statement is allowed to affect. If the
+// actual affected-row count falls outside the asserted bound, the statement
+// fails as if it had hit a runtime error, so any work it already did is
+// rolled back like any other failed statement.
+//
+//	delete from t where ... assert rows <= 1000
+//	update t set ... where ... assert rows between 1 and 100
+type RowsAssertion struct {
+	// Op is the comparison operator for the single-bound form (e.g. <=, =).
+	// It is unset (zero value EQUAL is not implied) when Between is true.
+	Op      ComparisonOp
+	Count   int64
+	Between bool
+	Lo, Hi  int64
+}
+
+func (node *RowsAssertion) Format(ctx *FmtCtx) {
+	ctx.WriteString("assert rows ")
+	if node.Between {
+		ctx.WriteString("between ")
+		ctx.WriteString(strconv.FormatInt(node.Lo, 10))
+		ctx.WriteString(" and ")
+		ctx.WriteString(strconv.FormatInt(node.Hi, 10))
+		return
+	}
+	ctx.WriteString(node.Op.ToString())
+	ctx.WriteByte(' ')
+	ctx.WriteString(strconv.FormatInt(node.Count, 10))
+}
+
+func NewRowsAssertion(op ComparisonOp, count int64) *RowsAssertion {
+	return &RowsAssertion{Op: op, Count: count}
+}
+
+func NewRowsAssertionBetween(lo, hi int64) *RowsAssertion {
+	return &RowsAssertion{Between: true, Lo: lo, Hi: hi}
+}
@@ -55,9 +55,15 @@ func (node *SnapshotLevelType) Format(ctx *FmtCtx) {
 	ctx.WriteString(node.Level.String())
 }
 
+// ObjName only holds a single "db.table" pair (SNAPSHOTLEVELTABLE); there
+// is no way to list an arbitrary set of tables across databases for a
+// single consistent snapshot. ACCOUNT/DATABASE level snapshots already
+// give one commit TS across every table they cover, so that's the
+// workaround when a caller needs a consistent cross-table snapshot today.
 type ObjectInfo struct {
-	SLevel  SnapshotLevelType // snapshot level
-	ObjName Identifier        // object name
+	SLevel   SnapshotLevelType // snapshot level
+	ObjName  Identifier        // object name
+	AtTsExpr *AtTimeStamp      // materialize the snapshot at this timestamp instead of now; table level only
 }
 
 func (node *ObjectInfo) Format(ctx *FmtCtx) {
@@ -67,6 +73,10 @@ func (node *ObjectInfo) Format(ctx *FmtCtx) {
 		ctx.WriteString(" ")
 	}
 	node.ObjName.Format(ctx)
+
+	if node.AtTsExpr != nil {
+		node.AtTsExpr.Format(ctx)
+	}
 }
 
 type CreateSnapShot struct {
@@ -75,6 +85,7 @@ type CreateSnapShot struct {
 	IfNotExists bool
 	Name        Identifier // snapshot name
 	Object      ObjectInfo
+	Comment     string // optional free-form note persisted alongside the snapshot
 }
 
 func (node *CreateSnapShot) Format(ctx *FmtCtx) {
@@ -83,6 +94,11 @@ func (node *CreateSnapShot) Format(ctx *FmtCtx) {
 		ctx.WriteString("if not exists ")
 	}
 	node.Name.Format(ctx)
+	if node.Comment != "" {
+		ctx.WriteString(" comment '")
+		ctx.WriteString(node.Comment)
+		ctx.WriteString("'")
+	}
 	ctx.WriteString(" for ")
 	node.Object.Format(ctx)
 }
@@ -127,7 +143,8 @@ func (node *DropSnapShot) GetQueryType() string { return QueryTypeOth }
 
 type ShowSnapShots struct {
 	statementImpl
-	Where *Where
+	Where   *Where
+	OrderBy OrderBy
 }
 
 func (node *ShowSnapShots) Format(ctx *FmtCtx) {
@@ -136,6 +153,10 @@ func (node *ShowSnapShots) Format(ctx *FmtCtx) {
 		ctx.WriteString(" ")
 		node.Where.Format(ctx)
 	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(" ")
+		node.OrderBy.Format(ctx)
+	}
 }
 
 func (node *ShowSnapShots) GetStatementType() string { return "Show Snapshot" }
@@ -174,6 +195,7 @@ type RestoreSnapShot struct {
 	TableName     Identifier // table name
 	SnapShotName  Identifier // snapshot name
 	ToAccountName Identifier // to account name
+	AsTableName   Identifier // new table name to materialize a table-level restore into, leaving the source table untouched
 }
 
 func (node *RestoreSnapShot) Format(ctx *FmtCtx) {
@@ -213,6 +235,11 @@ func (node *RestoreSnapShot) Format(ctx *FmtCtx) {
 		ctx.WriteString(" to account ")
 		node.ToAccountName.Format(ctx)
 	}
+
+	if len(node.AsTableName) > 0 {
+		ctx.WriteString(" as ")
+		node.AsTableName.Format(ctx)
+	}
 }
 
 func (node *RestoreSnapShot) GetStatementType() string { return "Restore Snapshot" }
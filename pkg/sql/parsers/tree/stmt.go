@@ -312,6 +312,10 @@ func (node *BackupStart) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
 
+func (node *VerifyBackup) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
 func (e *EmptyStmt) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -26,12 +26,13 @@ import (
 // update statement
 type Update struct {
 	statementImpl
-	Tables  TableExprs
-	Exprs   UpdateExprs
-	Where   *Where
-	OrderBy OrderBy
-	Limit   *Limit
-	With    *With
+	Tables        TableExprs
+	Exprs         UpdateExprs
+	Where         *Where
+	OrderBy       OrderBy
+	Limit         *Limit
+	With          *With
+	RowsAssertion *RowsAssertion
 }
 
 func (node *Update) Format(ctx *FmtCtx) {
@@ -61,6 +62,10 @@ func (node *Update) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Limit.Format(ctx)
 	}
+	if node.RowsAssertion != nil {
+		ctx.WriteByte(' ')
+		node.RowsAssertion.Format(ctx)
+	}
 }
 
 func (node *Update) GetStatementType() string { return "Update" }
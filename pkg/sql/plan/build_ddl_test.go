@@ -353,6 +353,13 @@ func TestBuildCreateTable(t *testing.T) {
 			"	UNIQUE KEY (`PRIMARY`)," +
 			"	UNIQUE KEY (`PRIMARY`, col3)" +
 			");",
+
+		`CREATE TABLE t4 (
+					col1 INT NOT NULL,
+					col2 INT NOT NULL,
+					PRIMARY KEY (col1),
+					CHECK (col2 > 0) ENFORCED
+				);`,
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
@@ -412,6 +419,11 @@ func TestBuildCreateTableError(t *testing.T) {
 			col4 INT NOT NULL,
 			UNIQUE KEY uk1 ((col1 + col3))
 		);`,
+
+		`CREATE TABLE t4 (
+			col1 INT NOT NULL,
+			col2 INT GENERATED ALWAYS AS (col1 + 1) STORED
+		);`,
 	}
 	runTestShouldError(mock, t, sqlerrs)
 }
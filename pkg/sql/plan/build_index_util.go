@@ -125,8 +125,10 @@ func setEmptyFullTextIndexName(namesMap map[string]bool, indexConstr *tree.FullT
 	}
 }
 
-// TODO
-// Currently, using expression as index keyparts are not supported in matrixone
+// checkIndexKeypartSupportability rejects expression keyparts (e.g.
+// CREATE INDEX idx ON t ((JSON_EXTRACT(...)))): the index table has nowhere
+// to maintain a computed column, so accepting the syntax would silently
+// build an index that never gets populated.
 func checkIndexKeypartSupportability(context context.Context, keyParts []*tree.KeyPart) error {
 	for _, key := range keyParts {
 		if key.Expr != nil {
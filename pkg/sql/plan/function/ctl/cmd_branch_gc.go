@@ -0,0 +1,115 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	branchGCList  = "list"
+	branchGCPurge = "purge"
+)
+
+// orphanBranchWhere is the same condition mo_catalog.mo_branch_orphans is
+// defined with: a live (not yet table_deleted) branch whose own table or
+// whose base table is missing from mo_tables.
+const orphanBranchWhere = `bm.table_deleted = false and (
+		not exists (select 1 from ` + catalog.MO_CATALOG + `.mo_tables t where t.rel_id = bm.table_id)
+		or not exists (select 1 from ` + catalog.MO_CATALOG + `.mo_tables pt where pt.rel_id = bm.p_table_id)
+	)`
+
+// select mo_ctl('cn', 'branch-gc', 'list'|'purge')
+//
+// Detects branch/clone tables whose mo_branch_metadata bookkeeping has
+// drifted from mo_tables -- the base table a branch was cloned from got
+// dropped, or the branch table itself is gone but nothing ever marked its
+// metadata row deleted -- the same rows mo_catalog.mo_branch_orphans lists.
+// "list" only counts them; "purge" also marks their metadata rows deleted
+// so they stop counting against branch quotas and stop showing up as
+// orphans. It does not physically drop a leftover branch table, since doing
+// that safely requires resolving table_id back to a db/table name per row;
+// operators should review the mo_branch_orphans rows first and drop those by
+// hand if the underlying table is still there.
+func handleBranchGC(
+	proc *process.Process,
+	service serviceType,
+	parameter string,
+	sender requestSender,
+) (Result, error) {
+	if service != cn {
+		return Result{}, moerr.NewWrongServiceNoCtx("expected CN", string(service))
+	}
+
+	policy := strings.ToLower(strings.TrimSpace(parameter))
+	switch policy {
+	case branchGCList, branchGCPurge:
+	default:
+		return Result{}, moerr.NewInvalidInput(proc.Ctx, "branch-gc policy must be 'list' or 'purge'")
+	}
+
+	exec, err := branchGCExecutor(proc)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if policy == branchGCPurge {
+		res, err := exec.Exec(proc.Ctx, `update `+catalog.MO_CATALOG+`.`+catalog.MO_BRANCH_METADATA+` bm
+			set table_deleted = true where `+orphanBranchWhere,
+			branchGCOptions(proc))
+		if err != nil {
+			return Result{}, err
+		}
+		defer res.Close()
+		return Result{Method: BranchGCMethod, Data: res.AffectedRows}, nil
+	}
+
+	res, err := exec.Exec(proc.Ctx, `select count(*) from `+catalog.MO_CATALOG+`.`+catalog.MO_BRANCH_METADATA+` bm
+		where `+orphanBranchWhere,
+		branchGCOptions(proc))
+	if err != nil {
+		return Result{}, err
+	}
+	defer res.Close()
+
+	var count int64
+	if len(res.Batches) > 0 && res.Batches[0].RowCount() > 0 {
+		count = vector.MustFixedColNoTypeCheck[int64](res.Batches[0].Vecs[0])[0]
+	}
+	return Result{Method: BranchGCMethod, Data: count}, nil
+}
+
+func branchGCExecutor(proc *process.Process) (executor.SQLExecutor, error) {
+	v, ok := moruntime.ServiceRuntime(proc.GetService()).GetGlobalVariables(moruntime.InternalSQLExecutor)
+	if !ok {
+		return nil, moerr.NewInternalError(proc.Ctx, "missing sql executor")
+	}
+	return v.(executor.SQLExecutor), nil
+}
+
+func branchGCOptions(proc *process.Process) executor.Options {
+	return executor.Options{}.
+		WithDisableIncrStatement().
+		WithTxn(proc.GetTxnOperator()).
+		WithDatabase(catalog.MO_CATALOG).
+		WithStatementOption(executor.StatementOption{}.WithDisableLog())
+}
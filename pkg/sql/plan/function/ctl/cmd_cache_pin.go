@@ -0,0 +1,89 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fagongzi/util/format"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// select mo_ctl('cn', 'cache-pin', 'table id[:budget bytes]')
+//
+// Pins a table's blocks in this CN's block cache so frequently joined
+// lookup tables don't keep getting re-fetched from S3. Only affects the CN
+// that handles the statement.
+func handleCachePin(
+	proc *process.Process,
+	service serviceType,
+	parameter string,
+	sender requestSender,
+) (Result, error) {
+	if service != cn {
+		return Result{}, moerr.NewWrongServiceNoCtx("expected CN", string(service))
+	}
+
+	idStr, budgetStr, hasBudget := strings.Cut(parameter, ":")
+	tableID, err := format.ParseStringUint64(idStr)
+	if err != nil {
+		return Result{}, moerr.NewInvalidInput(proc.Ctx, "invalid table id")
+	}
+
+	budget := objectio.DefaultCachePinBudget
+	if hasBudget {
+		budget, err = strconv.ParseInt(budgetStr, 10, 64)
+		if err != nil {
+			return Result{}, moerr.NewInvalidInput(proc.Ctx, "invalid budget")
+		}
+	}
+
+	if err := objectio.PinTable(tableID, budget); err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Method: CachePinMethod,
+		Data:   "OK",
+	}, nil
+}
+
+// select mo_ctl('cn', 'cache-unpin', 'table id')
+func handleCacheUnpin(
+	proc *process.Process,
+	service serviceType,
+	parameter string,
+	sender requestSender,
+) (Result, error) {
+	if service != cn {
+		return Result{}, moerr.NewWrongServiceNoCtx("expected CN", string(service))
+	}
+
+	tableID, err := format.ParseStringUint64(parameter)
+	if err != nil {
+		return Result{}, moerr.NewInvalidInput(proc.Ctx, "invalid table id")
+	}
+
+	objectio.UnpinTable(tableID)
+
+	return Result{
+		Method: CacheUnpinMethod,
+		Data:   "OK",
+	}, nil
+}
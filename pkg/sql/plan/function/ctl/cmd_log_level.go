@@ -0,0 +1,97 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"go.uber.org/zap/zapcore"
+)
+
+// defaultLogLevelTTL is used when the ttl field is omitted from the
+// LogMethod parameter.
+const defaultLogLevelTTL = 10 * time.Minute
+
+// handleLogLevel implements
+// mo_ctl('cn', 'log', 'module=txnimpl,level=debug,ttl=10m'), raising or
+// lowering the log level for one module on the service handling the call,
+// for the given ttl, after which it automatically reverts. This is meant to
+// chase down an in-progress issue, e.g. a commit stall or a flush anomaly,
+// without bumping the whole process's log level or restarting it.
+func handleLogLevel(
+	proc *process.Process,
+	service serviceType,
+	parameter string,
+	sender requestSender,
+) (Result, error) {
+	module, level, ttl, err := parseLogLevelParameter(parameter)
+	if err != nil {
+		return Result{}, err
+	}
+
+	logutil.SetModuleLogLevel(module, level, ttl)
+
+	return Result{
+		Method: LogMethod,
+		Data:   fmt.Sprintf("module %s set to level %s for %s", module, level, ttl),
+	}, nil
+}
+
+// parseLogLevelParameter parses "module=txnimpl,level=debug,ttl=10m". ttl is
+// optional and defaults to defaultLogLevelTTL.
+func parseLogLevelParameter(parameter string) (module string, level zapcore.Level, ttl time.Duration, err error) {
+	ttl = defaultLogLevelTTL
+
+	for _, kv := range strings.Split(parameter, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return "", 0, 0, moerr.NewInvalidInputNoCtxf("log parameter must be module=<name>,level=<level>[,ttl=<duration>], got %q", kv)
+		}
+
+		key, value := strings.ToLower(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1])
+		switch key {
+		case "module":
+			module = value
+		case "level":
+			if err := level.UnmarshalText([]byte(value)); err != nil {
+				return "", 0, 0, moerr.NewInvalidInputNoCtxf("invalid log level %q", value)
+			}
+		case "ttl":
+			d, derr := time.ParseDuration(value)
+			if derr != nil {
+				return "", 0, 0, moerr.NewInvalidInputNoCtxf("invalid ttl %q", value)
+			}
+			ttl = d
+		default:
+			return "", 0, 0, moerr.NewInvalidInputNoCtxf("unknown log parameter %q", key)
+		}
+	}
+
+	if module == "" {
+		return "", 0, 0, moerr.NewInvalidInputNoCtx("log parameter must include module=<name>")
+	}
+
+	return module, level, ttl, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestParseLogLevelParameter(t *testing.T) {
+	module, level, ttl, err := parseLogLevelParameter("module=txnimpl,level=debug,ttl=10m")
+	require.NoError(t, err)
+	require.Equal(t, "txnimpl", module)
+	require.Equal(t, zapcore.DebugLevel, level)
+	require.Equal(t, 10*time.Minute, ttl)
+
+	module, level, ttl, err = parseLogLevelParameter("module=txnimpl,level=warn")
+	require.NoError(t, err)
+	require.Equal(t, "txnimpl", module)
+	require.Equal(t, zapcore.WarnLevel, level)
+	require.Equal(t, defaultLogLevelTTL, ttl)
+
+	_, _, _, err = parseLogLevelParameter("level=debug")
+	require.Error(t, err)
+
+	_, _, _, err = parseLogLevelParameter("module=txnimpl,level=notalevel")
+	require.Error(t, err)
+
+	_, _, _, err = parseLogLevelParameter("module=txnimpl,level=debug,ttl=notaduration")
+	require.Error(t, err)
+
+	_, _, _, err = parseLogLevelParameter("module=txnimpl,bogus=1")
+	require.Error(t, err)
+}
+
+func TestHandleLogLevel(t *testing.T) {
+	defer logutil.ClearModuleLogLevel("txnimpl")
+
+	result, err := handleLogLevel(nil, cn, "module=txnimpl,level=debug,ttl=1m", nil)
+	require.NoError(t, err)
+	require.Equal(t, LogMethod, result.Method)
+
+	_, err = handleLogLevel(nil, cn, "level=debug", nil)
+	require.Error(t, err)
+}
@@ -66,7 +66,7 @@ type arguments struct {
 // "o:tableID[.accountID]:obj1, obj2...[:targetObjSize]"
 // "t:dbName.tableName[[.accountID]:filter:targetObjSize]"
 //
-// filter: "overlap", "small"
+// filter: "overlap", "small", "range(lo,hi)"
 // filter default: "basic"
 // targetObjSize: "1G", "1M", "1K"
 // targetObjSize default: "120M"
@@ -420,11 +420,37 @@ func applyMergePolicy(ctx context.Context, policyName string, sortKeyPos int, ob
 		}
 		selectedObjs, remainObjs := NewOverlap(maxObjects).Filter(objStats)
 		return selectedObjs, remainObjs, nil
+	} else if strings.HasPrefix(policyName, "range") {
+		if sortKeyPos == -1 {
+			return objStats, nil, nil
+		}
+		lo, hi, err := parseRangeArg(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		selectedObjs, remainObjs := NewRangeOverlap(lo, hi).Filter(objStats)
+		return selectedObjs, remainObjs, nil
 	}
 
 	return nil, nil, moerr.NewInvalidInput(ctx, "invalid merge policy name")
 }
 
+// parseRangeArg parses the "lo,hi" argument of the "range(lo,hi)" merge
+// policy into its two int64 bounds.
+func parseRangeArg(arg string) (lo, hi int64, err error) {
+	parts := strings.Split(arg, ",")
+	if len(parts) != 2 {
+		return 0, 0, moerr.NewInvalidArgNoCtx("range(lo,hi)", arg)
+	}
+	if lo, err = strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64); err != nil {
+		return 0, 0, errors.Join(moerr.NewInvalidArgNoCtx("range(lo,hi)", arg), err)
+	}
+	if hi, err = strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64); err != nil {
+		return 0, 0, errors.Join(moerr.NewInvalidArgNoCtx("range(lo,hi)", arg), err)
+	}
+	return lo, hi, nil
+}
+
 func cutBetween(s, start, end string) string {
 	i := strings.Index(s, start)
 	if i >= 0 {
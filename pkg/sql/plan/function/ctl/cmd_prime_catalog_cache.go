@@ -0,0 +1,81 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"regexp"
+
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/query"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"go.uber.org/zap"
+)
+
+// PrimeCatalogCacheForNewTable is called right after a CREATE TABLE commits
+// on the local CN. It registers "db.table" as a prefetch-on-subscribed
+// pattern cluster-wide, so whichever CN subscribes to the new table's
+// logtail first (typically another CN serving the table's first query)
+// eagerly pulls the full partition state instead of paying the usual lazy,
+// incremental cold-start path.
+//
+// This deliberately reuses the existing CtlPrefetchOnSubscribed CN-to-CN
+// command rather than introducing a new one: a dedicated "subscribe this
+// table now, everywhere" broadcast would need its own request/response
+// message, and this deployment's protobuf toolchain isn't available to
+// regenerate pkg/pb/query safely. The existing command already gets us most
+// of the win (no cold full-table fetch on first access elsewhere) with zero
+// wire-format changes.
+//
+// Errors are logged and swallowed: this is a latency optimization, not
+// something that should fail or roll back the CREATE TABLE that triggered
+// it.
+func PrimeCatalogCacheForNewTable(proc *process.Process, dbName, tableName string) {
+	pattern := "^" + regexp.QuoteMeta(dbName) + `\.` + regexp.QuoteMeta(tableName) + "$"
+	if err := engine.AddPrefetchOnSubscribedPattern(pattern); err != nil {
+		logutil.Error("prime-catalog-cache-for-new-table: local pattern update failed",
+			zap.String("db", dbName), zap.String("table", tableName), zap.Error(err))
+		return
+	}
+
+	// Broadcast the merged, locally-capped pattern set (not just the new
+	// entry) so that this fan-out composes with SetPrefetchOnSubscribed's
+	// existing replace-the-whole-set semantics on the receiving CN instead
+	// of silently dropping whatever that CN already knew about.
+	patterns := engine.GetPrefetchOnSubscribedPatterns()
+
+	cns := make([]string, 0)
+	clusterservice.GetMOCluster(proc.GetService()).GetCNService(clusterservice.Selector{}, func(cn metadata.CNService) bool {
+		cns = append(cns, cn.ServiceID)
+		return true
+	})
+
+	local := proc.GetQueryClient().ServiceID()
+	for _, id := range cns {
+		if id == local {
+			continue
+		}
+		request := proc.GetQueryClient().NewRequest(query.CmdMethod_CtlPrefetchOnSubscribed)
+		request.CtlPrefetchOnSubscribedRequest = &query.CtlPrefetchOnSubscribedRequest{
+			Patterns: patterns,
+		}
+		if _, err := TransferRequest2OtherCNs(proc, id, request); err != nil {
+			logutil.Error("prime-catalog-cache-for-new-table: notify cn failed",
+				zap.String("cn", id), zap.String("db", dbName), zap.String("table", tableName), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const defaultShuffleMonitorTopN = 10
+
+// select mo_ctl('cn', 'shuffle_monitor', 'top'[:N])
+//
+// Reports up to N (10 by default) queries this CN has run with the worst
+// shuffle locality ratio, as attributed by v2.RecordShuffleLocalityStats.
+// This is CN-local only: shuffle locality is a per-CN concern (rows either
+// stayed on the CN that produced them or crossed the network to another
+// one), so unlike e.g. WorkspaceThreshold there is no cross-CN fan-out here.
+// "top" is the only subcommand for now; interval-configurable periodic
+// reporting to a pluggable sink is a separate, materially larger change
+// (see the synth-1277 note in this repo's history for what that would need).
+func handleShuffleMonitor(
+	proc *process.Process,
+	service serviceType,
+	parameter string,
+	sender requestSender,
+) (Result, error) {
+	if service != cn {
+		return Result{}, moerr.NewWrongServiceNoCtx("expected CN", string(service))
+	}
+
+	sub, arg, _ := strings.Cut(parameter, ":")
+	sub = strings.ToLower(sub)
+	if sub != "top" {
+		return Result{}, moerr.NewInvalidInputf(proc.Ctx, "unsupported shuffle_monitor subcommand '%s', expected 'top'", sub)
+	}
+
+	n := defaultShuffleMonitorTopN
+	if arg != "" {
+		parsed, err := strconv.Atoi(arg)
+		if err != nil || parsed <= 0 {
+			return Result{}, moerr.NewInvalidInputf(proc.Ctx, "invalid top N '%s'", arg)
+		}
+		n = parsed
+	}
+
+	return Result{
+		Method: ShuffleMonitorMethod,
+		Data:   v2.TopWorstShuffleLocality(n),
+	}, nil
+}
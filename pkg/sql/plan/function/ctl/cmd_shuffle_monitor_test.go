@@ -0,0 +1,52 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleShuffleMonitor(t *testing.T) {
+	proc := new(process.Process)
+	proc.Base = &process.BaseProcess{}
+
+	_, err := handleShuffleMonitor(proc, tn, "top", nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrWrongService))
+
+	_, err = handleShuffleMonitor(proc, cn, "bogus", nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+
+	_, err = handleShuffleMonitor(proc, cn, "top:nope", nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+
+	v2.RecordShuffleLocalityStats("q-all-local", 100, 0)
+	v2.RecordShuffleLocalityStats("q-half-local", 50, 50)
+	v2.RecordShuffleLocalityStats("q-all-remote", 0, 100)
+
+	result, err := handleShuffleMonitor(proc, cn, "top:2", nil)
+	require.NoError(t, err)
+	require.Equal(t, ShuffleMonitorMethod, result.Method)
+
+	stats, ok := result.Data.([]v2.QueryLocalityStat)
+	require.True(t, ok)
+	require.Len(t, stats, 2)
+	require.Equal(t, "q-all-remote", stats[0].QueryID)
+	require.Equal(t, "q-half-local", stats[1].QueryID)
+}
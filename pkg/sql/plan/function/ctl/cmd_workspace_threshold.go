@@ -23,14 +23,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"strconv"
 	"strings"
-	"time"
 )
 
-type Res struct {
-	PodID           string `json:"pod_id,omitempty"`
-	CommitThreshold uint64 `json:"commit_threshold,omitempty"`
-	WriteThreshold  uint64 `json:"write_threshold,omitempty"`
-	ErrorStr        string `json:"error,omitempty"`
+// WorkspaceThresholdResult is the per-node Data payload of a successful
+// FanOutNodeResult for this command.
+type WorkspaceThresholdResult struct {
+	CommitThreshold uint64 `json:"commit_threshold"`
+	WriteThreshold  uint64 `json:"write_threshold"`
 }
 
 func handleWorkspaceThreshold(
@@ -62,32 +61,20 @@ func handleWorkspaceThreshold(
 		WriteThreshold:  write,
 	}
 
-	results := make([]Res, 0)
-
-	clusterservice.GetMOCluster(
-		proc.GetService()).GetCNService(clusterservice.Selector{}, func(cn metadata.CNService) bool {
-		ctx, cancel := context.WithTimeoutCause(context.Background(), time.Second, moerr.CauseTransferRequest2OtherCNs)
-		defer cancel()
-
-		resp, err := proc.GetQueryClient().SendMessage(ctx, cn.QueryAddress, request)
-		err = moerr.AttachCause(ctx, err)
-
-		res := Res{
-			PodID: cn.ServiceID,
-		}
-
-		if err != nil {
-			res.ErrorStr = err.Error()
-		} else {
-			res.CommitThreshold = resp.WorkspaceThresholdResponse.CommitThreshold
-			res.WriteThreshold = resp.WorkspaceThresholdResponse.WriteThreshold
-		}
-		results = append(results, res)
-		return true
-	})
+	result := FanOutToCNs(proc, clusterservice.Selector{}, DefaultFanOutOptions(),
+		func(ctx context.Context, cn metadata.CNService) (any, error) {
+			resp, err := proc.GetQueryClient().SendMessage(ctx, cn.QueryAddress, request)
+			if err != nil {
+				return nil, err
+			}
+			return WorkspaceThresholdResult{
+				CommitThreshold: resp.WorkspaceThresholdResponse.CommitThreshold,
+				WriteThreshold:  resp.WorkspaceThresholdResponse.WriteThreshold,
+			}, nil
+		})
 
 	return Result{
 		Method: WorkspaceThreshold,
-		Data:   results,
+		Data:   result,
 	}, nil
 }
@@ -49,10 +49,12 @@ func TestHandleWorkspaceThreshold(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, result, Result{
 		Method: WorkspaceThreshold,
-		Data: []Res{
-			{
-				PodID:    "not exist",
-				ErrorStr: "internal error: invalid CN query address ",
+		Data: FanOutResult{
+			Results: []FanOutNodeResult{
+				{
+					NodeID: "not exist",
+					Err:    "internal error: invalid CN query address ",
+				},
 			},
 		},
 	})
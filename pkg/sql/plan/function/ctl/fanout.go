@@ -0,0 +1,99 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// FanOutNodeResult is one CN's outcome from a fan-out-to-all-CNs ctl
+// command: either Data is set, or Err is, never both. This is the
+// consistent per-node shape commands like handleTraceSpan/
+// handleWorkspaceThreshold used to build ad hoc (a loose string, or their
+// own single-purpose struct).
+type FanOutNodeResult struct {
+	NodeID string `json:"node_id"`
+	Data   any    `json:"data,omitempty"`
+	Err    string `json:"error,omitempty"`
+}
+
+// FanOutResult is the aggregate outcome of a fan-out-to-all-CNs ctl
+// command. OK is false as soon as any single node failed, so a caller can
+// tell a partial success apart from a full one without scanning Results.
+type FanOutResult struct {
+	Results []FanOutNodeResult `json:"results"`
+	OK      bool               `json:"ok"`
+}
+
+// FanOutOptions controls how FanOutToCNs talks to each node.
+type FanOutOptions struct {
+	// Timeout bounds a single attempt against a single node.
+	Timeout time.Duration
+	// MaxRetries is how many additional attempts are made against a node
+	// after its first attempt fails. 0 means no retry.
+	MaxRetries int
+}
+
+// DefaultFanOutOptions matches the one-second, no-retry behavior the
+// existing ad hoc fan-out commands used.
+func DefaultFanOutOptions() FanOutOptions {
+	return FanOutOptions{Timeout: time.Second, MaxRetries: 0}
+}
+
+// FanOutToCNs calls send once per CN matched by selector, retrying a node
+// up to opts.MaxRetries times on error, and collects the outcome of every
+// node into a FanOutResult -- so a handful of slow or unreachable CNs
+// degrade a multi-CN ctl command to a partial-success result instead of
+// either losing their failure in a free-form string or failing the whole
+// command.
+func FanOutToCNs(
+	proc *process.Process,
+	selector clusterservice.Selector,
+	opts FanOutOptions,
+	send func(ctx context.Context, cn metadata.CNService) (any, error),
+) FanOutResult {
+	result := FanOutResult{OK: true}
+	clusterservice.GetMOCluster(proc.GetService()).GetCNService(selector, func(cn metadata.CNService) bool {
+		node := FanOutNodeResult{NodeID: cn.ServiceID}
+
+		var data any
+		var err error
+		for attempt := 0; attempt <= opts.MaxRetries; attempt++ {
+			ctx, cancel := context.WithTimeoutCause(context.Background(), opts.Timeout, moerr.CauseTransferRequest2OtherCNs)
+			data, err = send(ctx, cn)
+			err = moerr.AttachCause(ctx, err)
+			cancel()
+			if err == nil {
+				break
+			}
+		}
+
+		if err != nil {
+			node.Err = err.Error()
+			result.OK = false
+		} else {
+			node.Data = data
+		}
+		result.Results = append(result.Results, node)
+		return true
+	})
+	return result
+}
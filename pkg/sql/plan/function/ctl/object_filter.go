@@ -19,6 +19,7 @@ import (
 	"math"
 	"slices"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
@@ -36,6 +37,80 @@ func NewOverlap(maxObjects int) Filter {
 	return &overlap{maxEntries: maxObjects}
 }
 
+// NewRangeOverlap returns a Filter that selects only the objects whose
+// sort-key zonemap overlaps the closed range [lo, hi], leaving objects that
+// fall entirely outside it untouched. It's meant for callers that already
+// know exactly which part of the sorted key space they just modified --
+// e.g. merging a branch whose writes were localized to a handful of key
+// ranges -- so compaction only rewrites the objects that can possibly
+// contain the changed rows instead of the whole table.
+func NewRangeOverlap(lo, hi int64) Filter {
+	return &rangeOverlap{lo: lo, hi: hi}
+}
+
+type rangeOverlap struct {
+	lo, hi int64
+}
+
+func (r *rangeOverlap) Filter(objs []objectio.ObjectStats) ([]objectio.ObjectStats, []objectio.ObjectStats) {
+	if len(objs) == 0 {
+		return nil, nil
+	}
+	t := objs[0].SortKeyZoneMap().GetType()
+	lo, hi, err := rangeBoundsAsType(t, r.lo, r.hi)
+	if err != nil {
+		// sort key isn't a type we can compare int64 bounds against,
+		// leave every object untouched rather than guessing.
+		return nil, objs
+	}
+
+	selected := make([]objectio.ObjectStats, 0, len(objs))
+	remain := make([]objectio.ObjectStats, 0, len(objs))
+	for _, obj := range objs {
+		zm := obj.SortKeyZoneMap()
+		if !zm.IsInited() || compute.CompareGeneric(zm.GetMin(), hi, t) > 0 || compute.CompareGeneric(zm.GetMax(), lo, t) < 0 {
+			remain = append(remain, obj)
+			continue
+		}
+		selected = append(selected, obj)
+	}
+	return selected, remain
+}
+
+// rangeBoundsAsType converts the int64 range bounds given on the mo_ctl
+// command line into the concrete type the table's sort key zonemap stores,
+// so they can be compared with compute.CompareGeneric.
+func rangeBoundsAsType(t types.T, lo, hi int64) (any, any, error) {
+	switch t {
+	case types.T_int8:
+		return int8(lo), int8(hi), nil
+	case types.T_int16:
+		return int16(lo), int16(hi), nil
+	case types.T_int32:
+		return int32(lo), int32(hi), nil
+	case types.T_int64:
+		return lo, hi, nil
+	case types.T_uint8, types.T_bit:
+		return uint8(lo), uint8(hi), nil
+	case types.T_uint16:
+		return uint16(lo), uint16(hi), nil
+	case types.T_uint32:
+		return uint32(lo), uint32(hi), nil
+	case types.T_uint64:
+		return uint64(lo), uint64(hi), nil
+	case types.T_date:
+		return types.Date(lo), types.Date(hi), nil
+	case types.T_time:
+		return types.Time(lo), types.Time(hi), nil
+	case types.T_datetime:
+		return types.Datetime(lo), types.Datetime(hi), nil
+	case types.T_timestamp:
+		return types.Timestamp(lo), types.Timestamp(hi), nil
+	default:
+		return nil, nil, moerr.NewNotSupportedNoCtx(fmt.Sprintf("range merge policy on sort key type %v", t))
+	}
+}
+
 type small struct {
 	threshold uint32
 }
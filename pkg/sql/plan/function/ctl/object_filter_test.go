@@ -156,3 +156,41 @@ func TestOverlap(t *testing.T) {
 		require.Equal(t, 2, len(result))
 	}
 }
+
+func TestRangeOverlap(t *testing.T) {
+	bs := make([]byte, 4)
+	newInt32Stats := func(min, max int32) objectio.ObjectStats {
+		zm := index.NewZM(types.T_int32, 0)
+		binary.LittleEndian.PutUint32(bs, uint32(min))
+		index.UpdateZM(zm, bs)
+		binary.LittleEndian.PutUint32(bs, uint32(max))
+		index.UpdateZM(zm, bs)
+		stats := objectio.NewObjectStats()
+		objectio.SetObjectStatsSortKeyZoneMap(stats, zm)
+		return *stats
+	}
+
+	// [0,10] and [20,30] exist, only [0,10] overlaps range [5,15]
+	inRange := newInt32Stats(0, 10)
+	outOfRange := newInt32Stats(20, 30)
+
+	selected, remain := NewRangeOverlap(5, 15).Filter([]objectio.ObjectStats{inRange, outOfRange})
+	require.Equal(t, []objectio.ObjectStats{inRange}, selected)
+	require.Equal(t, []objectio.ObjectStats{outOfRange}, remain)
+
+	// a range fully outside every object selects nothing
+	selected, remain = NewRangeOverlap(100, 200).Filter([]objectio.ObjectStats{inRange, outOfRange})
+	require.Empty(t, selected)
+	require.Len(t, remain, 2)
+
+	// a sort key type we can't cast the int64 bounds into leaves everything untouched
+	zm := index.NewZM(types.T_varchar, 0)
+	index.UpdateZM(zm, []byte("a"))
+	index.UpdateZM(zm, []byte("z"))
+	stringStats := objectio.NewObjectStats()
+	objectio.SetObjectStatsSortKeyZoneMap(stringStats, zm)
+
+	selected, remain = NewRangeOverlap(0, 10).Filter([]objectio.ObjectStats{*stringStats})
+	require.Empty(t, selected)
+	require.Len(t, remain, 1)
+}
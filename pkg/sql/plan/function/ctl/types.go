@@ -60,6 +60,12 @@ var (
 	WorkspaceThreshold       = strings.ToUpper("WorkspaceThreshold")
 	TableExtra               = strings.ToUpper("table-extra")
 	PrefetchOnSubscribed     = strings.ToUpper("prefetch-on-subscribed")
+	CachePinMethod           = strings.ToUpper("cache-pin")
+	CacheUnpinMethod         = strings.ToUpper("cache-unpin")
+	BranchGCMethod           = strings.ToUpper("branch-gc")
+	BranchTouchMethod        = strings.ToUpper("branch-touch")
+	LogMethod                = "LOG"
+	ShuffleMonitorMethod     = strings.ToUpper("shuffle_monitor")
 )
 
 var (
@@ -104,6 +110,12 @@ var (
 		WorkspaceThreshold:       handleWorkspaceThreshold,
 		TableExtra:               handleTableExtra,
 		PrefetchOnSubscribed:     handlePrefetchOnSubscribed,
+		CachePinMethod:           handleCachePin,
+		CacheUnpinMethod:         handleCacheUnpin,
+		BranchGCMethod:           handleBranchGC,
+		BranchTouchMethod:        handleBranchTouch,
+		LogMethod:                handleLogLevel,
+		ShuffleMonitorMethod:     handleShuffleMonitor,
 	}
 )
 
@@ -33,6 +33,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/collate"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -5300,6 +5301,23 @@ func strcmp(s1, s2 string) (int8, error) {
 	return 1, nil
 }
 
+// StrCmpCi is strcmp's case-insensitive counterpart, comparing its arguments
+// the way a *_general_ci/*_unicode_ci collation would (see pkg/common/collate).
+func StrCmpCi(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) (err error) {
+	return opBinaryStrStrToFixedWithErrorCheck[int8](ivecs, result, proc, length, strcmpci, nil)
+}
+
+func strcmpci(s1, s2 string) (int8, error) {
+	switch c := collate.CompareFold(s1, s2); {
+	case c < 0:
+		return -1, nil
+	case c > 0:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
 func SubStringWith2Args(ivecs []*vector.Vector, result vector.FunctionResultWrapper, _ *process.Process, length int, selectList *FunctionSelectList) (err error) {
 	rs := vector.MustFunctionResult[types.Varlena](result)
 	vs := vector.GenerateFunctionStrParameter(ivecs[0])
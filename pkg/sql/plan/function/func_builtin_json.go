@@ -436,6 +436,89 @@ func (op *opBuiltInJsonExtract) jsonExtractFloat64(parameters []*vector.Vector,
 	return nil
 }
 
+// JSON_MERGE_PATCH
+func jsonMergePatchCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) > 1 {
+		ts := make([]types.Type, 0, len(inputs))
+		allMatch := true
+		for _, input := range inputs {
+			if input.Oid == types.T_json || input.Oid.IsMySQLString() {
+				ts = append(ts, input)
+			} else {
+				if canCast, _ := fixedImplicitTypeCast(input, types.T_varchar); canCast {
+					ts = append(ts, types.T_varchar.ToType())
+					allMatch = false
+				} else {
+					return newCheckResultWithFailure(failedFunctionParametersWrong)
+				}
+			}
+		}
+		if allMatch {
+			return newCheckResultWithSuccess(0)
+		}
+		return newCheckResultWithCast(0, ts)
+	}
+	return newCheckResultWithFailure(failedFunctionParametersWrong)
+}
+
+func decodeJsonDoc(vec *vector.Vector, data []byte) (bytejson.ByteJson, error) {
+	if vec.GetType().Oid == types.T_json {
+		return types.DecodeJson(data), nil
+	}
+	return types.ParseSliceToByteJson(data)
+}
+
+type opBuiltInJsonMergePatch struct {
+}
+
+func newOpBuiltInJsonMergePatch() *opBuiltInJsonMergePatch {
+	return &opBuiltInJsonMergePatch{}
+}
+
+func (op *opBuiltInJsonMergePatch) jsonMergePatch(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	wrappers := make([]vector.FunctionParameterWrapper[types.Varlena], len(parameters))
+	for i, param := range parameters {
+		wrappers[i] = vector.GenerateFunctionStrParameter(param)
+	}
+
+	docs := make([]bytejson.ByteJson, len(parameters))
+	for i := uint64(0); i < uint64(length); i++ {
+		isNullRow := false
+		for j, w := range wrappers {
+			docBytes, isNull := w.GetStrValue(i)
+			if isNull {
+				isNullRow = true
+				break
+			}
+			doc, err := decodeJsonDoc(parameters[j], docBytes)
+			if err != nil {
+				return err
+			}
+			docs[j] = doc
+		}
+		if isNullRow {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		merged := docs[0]
+		var err error
+		for _, doc := range docs[1:] {
+			merged, err = merged.MergePatch(doc)
+			if err != nil {
+				return err
+			}
+		}
+		if err = rs.AppendByteJson(merged, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type opBuiltInJsonSet struct {
 }
 
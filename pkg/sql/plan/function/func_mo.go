@@ -958,6 +958,8 @@ var (
 		catalog.MO_TABLE_STATS:        0,
 		catalog.MO_MERGE_SETTINGS:     0,
 		catalog.MO_BRANCH_METADATA:    0,
+		"mo_branch_orphans":           0,
+		"mo_branch_status":            0,
 
 		catalog.MO_TABLES_LOGICAL_ID_INDEX_TABLE_NAME: 0,
 		catalog.MO_FEATURE_LIMIT:                      0,
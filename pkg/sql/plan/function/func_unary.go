@@ -56,7 +56,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/functionUtil"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/fault"
+	"github.com/matrixorigin/matrixone/pkg/util/json"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/lengthutf8"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/moarray"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/momath"
@@ -1408,6 +1410,20 @@ func ConnectionID(_ []*vector.Vector, result vector.FunctionResultWrapper, proc
 	})
 }
 
+// MoTxnWriteStats returns, as a JSON document, the row count, approximate
+// byte size, and list of tables touched by the current transaction's
+// pending, uncommitted writes. It lets a long-running job decide whether to
+// checkpoint or split its work before hitting a commit-size limit.
+func MoTxnWriteStats(_ []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	stats := client.WriteStats{}
+	if op := proc.GetTxnOperator(); op != nil {
+		stats = op.GetWorkspace().WriteStats()
+	}
+	return opNoneParamToBytes(result, proc, length, func() []byte {
+		return json.Pretty(stats)
+	})
+}
+
 // HexString returns a hexadecimal string representation of a string.
 // See https://dev.mysql.com/doc/refman/5.7/en/string-functions.html#function_hex
 func HexString(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
@@ -585,9 +585,21 @@ const (
 	IN_RANGE        = 404
 	PREFIX_IN_RANGE = 405
 
+	// function `strcmpci`
+	STRCMPCI = 406
+
+	// function `mo_hash64`
+	MO_HASH64 = 407
+
+	// function `mo_txn_write_stats`
+	MO_TXN_WRITE_STATS = 408
+
+	// function `json_merge_patch`
+	JSON_MERGE_PATCH = 409
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
-	FUNCTION_END_NUMBER = 406
+	FUNCTION_END_NUMBER = 410
 )
 
 // functionIdRegister is what function we have registered already.
@@ -726,6 +738,7 @@ var functionIdRegister = map[string]int32{
 	"rpad":              RPAD,
 	"soundex":           SOUNDEX,
 	"strcmp":            STRCMP,
+	"strcmpci":          STRCMPCI,
 	"substr":            SUBSTRING,
 	"substring":         SUBSTRING,
 	"mid":               SUBSTRING,
@@ -859,6 +872,7 @@ var functionIdRegister = map[string]int32{
 	"json_set":                       JSON_SET,
 	"json_insert":                    JSON_INSERT,
 	"json_replace":                   JSON_REPLACE,
+	"json_merge_patch":               JSON_MERGE_PATCH,
 	"jq":                             JQ,
 	"try_jq":                         TRY_JQ,
 	"moplugin":                       WASM,
@@ -993,6 +1007,9 @@ var functionIdRegister = map[string]int32{
 	"mo_cpu":      MO_CPU,
 	"mo_memory":   MO_MEMORY,
 	"mo_cpu_dump": MO_CPU_DUMP,
+
+	"mo_hash64":          MO_HASH64,
+	"mo_txn_write_stats": MO_TXN_WRITE_STATS,
 	// bitmap function
 	"bitmap_bit_position":  BITMAP_BIT_POSITION,
 	"bitmap_bucket_number": BITMAP_BUCKET_NUMBER,
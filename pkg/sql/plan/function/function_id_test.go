@@ -459,7 +459,11 @@ var predefinedFunids = map[int]int{
 	MO_FEATURE_LIMIT_UPSERT:    403,
 	IN_RANGE:                   404,
 	PREFIX_IN_RANGE:            405,
-	FUNCTION_END_NUMBER:        406,
+	STRCMPCI:                   406,
+	MO_HASH64:                  407,
+	MO_TXN_WRITE_STATS:         408,
+	JSON_MERGE_PATCH:           409,
+	FUNCTION_END_NUMBER:        410,
 }
 
 func Test_funids(t *testing.T) {
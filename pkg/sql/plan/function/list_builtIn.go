@@ -1589,6 +1589,26 @@ var supportedStringBuiltIns = []FuncNew{
 		},
 	},
 
+	// function `json_merge_patch`
+	{
+		functionId: JSON_MERGE_PATCH,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonMergePatchCheckFn,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return newOpBuiltInJsonMergePatch().jsonMergePatch
+				},
+			},
+		},
+	},
+
 	// function `least`
 	{
 		functionId: LEAST,
@@ -2835,6 +2855,27 @@ var supportedStringBuiltIns = []FuncNew{
 		},
 	},
 
+	// strcmpci
+	{
+		functionId: STRCMPCI,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_varchar, types.T_varchar},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int8.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return StrCmpCi
+				},
+			},
+		},
+	},
+
 	// function `substring`, `substr`, `mid`
 	{
 		functionId: SUBSTRING,
@@ -3620,6 +3661,11 @@ var supportedArrayOperations = []FuncNew{
 		},
 	},
 
+	// l2_distance and the cosine/inner-product functions above get no
+	// zonemap pushdown for ORDER BY dist(col, :q) LIMIT k style ANN
+	// queries; see overload/CompileFilterExpr for why that's a planner
+	// change, not a local one.
+
 	// function `l2_distance`
 	{
 		functionId: L2_DISTANCE,
@@ -9105,6 +9151,60 @@ var supportedOthersBuiltIns = []FuncNew{
 		},
 	},
 
+	// function `mo_hash64`
+	// mo_hash64(col1, ..., colN, seed) is hash_partition with the seed folded
+	// in as just another column to hash over. Since the seed is typically a
+	// query-scoped user variable (e.g. @seed), the same row hashes the same
+	// way for every call within a query but differently across queries that
+	// pick a different seed -- useful for reproducible sampling such as
+	// `where mo_hash64(id, @seed) % 10 < 8`.
+	{
+		functionId: MO_HASH64,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) > 1 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_uint64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return builtInHashPartition
+				},
+			},
+		},
+	},
+
+	// function `mo_txn_write_stats`
+	{
+		functionId: MO_TXN_WRITE_STATS,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId:      0,
+				args:            []types.T{},
+				volatile:        true,
+				realTimeRelated: true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return MoTxnWriteStats
+				},
+			},
+		},
+	},
+
 	// function `icu_version`
 	{
 		functionId: ICULIBVERSION,
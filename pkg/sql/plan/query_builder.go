@@ -27,6 +27,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -5307,6 +5308,12 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildParseJsonlFile(tbl, ctx, exprs, children)
 	case "table_stats":
 		nodeId = builder.buildTableStats(tbl, ctx, exprs, children)
+	case "row_history":
+		nodeId = builder.buildRowHistory(tbl, ctx, exprs, children)
+	case "table_diff":
+		nodeId = builder.buildTableDiff(tbl, ctx, exprs, children)
+	case "stats_drift":
+		nodeId = builder.buildStatsDrift(tbl, ctx, exprs, children)
 	case "load_file_chunks":
 		nodeId = builder.buildLoadFileChunks(tbl, ctx, exprs, children)
 	default:
@@ -5416,6 +5423,11 @@ func (builder *QueryBuilder) ResolveTsHint(tsExpr *tree.AtTimeStamp) (snapshot *
 	if err != nil {
 		return
 	}
+	// allow something like {MO_TS = NOW() - INTERVAL 1 HOUR}: fold it down to a
+	// literal here so the real-time-related NOW() survives (varAndParamIsConst=true).
+	if defExpr, err = ConstantFold(batch.EmptyForConstFoldBatch, defExpr, builder.compCtx.GetProcess(), true, true); err != nil {
+		return
+	}
 	exprLit, ok := defExpr.Expr.(*plan.Expr_Lit)
 	if !ok {
 		err = moerr.NewParseError(builder.GetContext(), "invalid timestamp hint")
@@ -5462,12 +5474,19 @@ func (builder *QueryBuilder) ResolveTsHint(tsExpr *tree.AtTimeStamp) (snapshot *
 		} else if tsExpr.Type == tree.ATTIMESTAMPSNAPSHOT {
 			return builder.compCtx.ResolveSnapshotWithSnapshotName(lit.Sval)
 		} else if tsExpr.Type == tree.ATMOTIMESTAMP {
-			var ts timestamp.Timestamp
-			if ts, err = timestamp.ParseTimestamp(lit.Sval); err != nil {
-				return
+			// MO_TS historically only took the debug "physical-logical" string
+			// produced by Timestamp.DebugString(). Fall back to treating it as
+			// a wall-clock datetime string (e.g. '2024-01-01 10:00:00') so users
+			// no longer have to pre-convert it to a TS themselves.
+			if ts, perr := timestamp.ParseTimestamp(lit.Sval); perr == nil {
+				snapshot = &Snapshot{TS: &ts, Tenant: tenant}
+			} else {
+				var tsNano int64
+				if tsNano, err = doResolveTimeStamp(lit.Sval); err != nil {
+					return
+				}
+				snapshot = &Snapshot{TS: &timestamp.Timestamp{PhysicalTime: tsNano}, Tenant: tenant}
 			}
-
-			snapshot = &Snapshot{TS: &ts, Tenant: tenant}
 		} else if tsExpr.Type == tree.ASOFTIMESTAMP {
 			var ts int64
 			if ts, err = doResolveTimeStamp(lit.Sval); err != nil {
@@ -5502,6 +5521,29 @@ func (builder *QueryBuilder) ResolveTsHint(tsExpr *tree.AtTimeStamp) (snapshot *
 			err = moerr.NewInvalidArg(builder.GetContext(), "invalid timestamp hint for snapshot hint", lit.I64Val)
 			return
 		}
+	case *plan.Literal_Datetimeval:
+		// produced by folding an expression like NOW() - INTERVAL 1 HOUR
+		if tsExpr.Type != tree.ATMOTIMESTAMP {
+			err = moerr.NewInvalidArg(builder.GetContext(), "invalid timestamp hint type", tsExpr.Type.String())
+			return
+		}
+		tsNano := types.Datetime(lit.Datetimeval).ConvertToGoTime(time.Local).UnixNano()
+		if tsNano <= 0 {
+			err = moerr.NewInvalidArg(builder.GetContext(), "invalid timestamp value", lit.Datetimeval)
+			return
+		}
+		snapshot = &Snapshot{TS: &timestamp.Timestamp{PhysicalTime: tsNano}, Tenant: tenant}
+	case *plan.Literal_Timestampval:
+		if tsExpr.Type != tree.ATMOTIMESTAMP {
+			err = moerr.NewInvalidArg(builder.GetContext(), "invalid timestamp hint type", tsExpr.Type.String())
+			return
+		}
+		tsNano := types.Timestamp(lit.Timestampval).Unix() * int64(time.Second)
+		if tsNano <= 0 {
+			err = moerr.NewInvalidArg(builder.GetContext(), "invalid timestamp value", lit.Timestampval)
+			return
+		}
+		snapshot = &Snapshot{TS: &timestamp.Timestamp{PhysicalTime: tsNano}, Tenant: tenant}
 	default:
 		err = moerr.NewInvalidArg(builder.GetContext(), "invalid input expr ", tsExpr.Expr.String())
 	}
@@ -0,0 +1,51 @@
+// Copyright 2025 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func makeMoTsHint(sval string) *tree.AtTimeStamp {
+	return &tree.AtTimeStamp{
+		Type: tree.ATMOTIMESTAMP,
+		Expr: tree.NewNumVal[string](sval, sval, false, tree.P_char),
+	}
+}
+
+func TestResolveTsHintWallClockString(t *testing.T) {
+	builder := NewQueryBuilder(plan.Query_SELECT, NewMockCompilerContext(true), false, true)
+
+	snapshot, err := builder.ResolveTsHint(makeMoTsHint("2024-01-01 10:00:00"))
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	require.Greater(t, snapshot.TS.PhysicalTime, int64(0))
+}
+
+func TestResolveTsHintDebugFormatStringStillWorks(t *testing.T) {
+	builder := NewQueryBuilder(plan.Query_SELECT, NewMockCompilerContext(true), false, true)
+
+	want := timestamp.Timestamp{PhysicalTime: 123456789, LogicalTime: 1}
+	snapshot, err := builder.ResolveTsHint(makeMoTsHint(want.DebugString()))
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	require.Equal(t, want.PhysicalTime, snapshot.TS.PhysicalTime)
+	require.Equal(t, want.LogicalTime, snapshot.TS.LogicalTime)
+}
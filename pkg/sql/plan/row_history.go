@@ -0,0 +1,79 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var (
+	RowHistoryColDefs  []*plan.ColDef
+	RowHistoryColTypes []types.Type
+)
+
+func init() {
+	// row_history('db.t', pk_value [, from_ts, to_ts]) returns every
+	// historical version of the row, one per commit that touched it.
+	RowHistoryColTypes = []types.Type{
+		types.New(types.T_TS, 0, 0),                        // commit_ts
+		types.New(types.T_varchar, types.MaxVarcharLen, 0), // op_type: "upsert" or "delete"
+		types.New(types.T_text, 0, 0),                      // row_json
+	}
+
+	colNames := []string{
+		"commit_ts",
+		"op_type",
+		"row_json",
+	}
+
+	RowHistoryColDefs = make([]*plan.ColDef, len(colNames))
+	for i, name := range colNames {
+		tp := RowHistoryColTypes[i]
+		RowHistoryColDefs[i] = &plan.ColDef{
+			Name: name,
+			Typ: plan.Type{
+				Id:          int32(tp.Oid),
+				Width:       tp.Width,
+				Scale:       tp.Scale,
+				NotNullable: true,
+			},
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+	}
+}
+
+func (builder *QueryBuilder) buildRowHistory(_ *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, children []int32) int32 {
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "row_history",
+			},
+			Cols: RowHistoryColDefs,
+		},
+		BindingTags:     []int32{builder.genNewBindTag()},
+		Children:        children,
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx)
+}
@@ -100,6 +100,28 @@ func SimpleInt64HashToRange(i uint64, upperLimit uint64) uint64 {
 	return hashtable.Int64HashWithFixedSeed(i) % upperLimit
 }
 
+// RendezvousHashCN picks, for a given key, which of cnIDs should own it,
+// using rendezvous (highest random weight) hashing: every candidate CN
+// gets a weight derived from (key, cnID), and the CN with the highest
+// weight wins. Unlike SimpleInt64HashToRange/mod-N bucketing, the winner
+// for a given key only changes when its old winner is removed or a newly
+// added CN happens to out-weigh it -- so a CN joining or leaving only
+// moves the keys that winner actually owned, instead of reshuffling
+// nearly everything the way growing or shrinking N does to a mod-N bucket
+// index.
+func RendezvousHashCN(key uint64, cnIDs []string) int {
+	best := -1
+	var bestWeight uint64
+	for i, id := range cnIDs {
+		weight := hashtable.Int64HashWithFixedSeed(key ^ ByteSliceToUint64([]byte(id)))
+		if best == -1 || weight > bestWeight {
+			best = i
+			bestWeight = weight
+		}
+	}
+	return best
+}
+
 func shuffleByZonemap(rsp *engine.RangesShuffleParam, zm objectio.ZoneMap, bucketNum int) uint64 {
 	if !rsp.Init {
 		rsp.Init = true
@@ -330,3 +330,44 @@ func TestShuffleByValueExtractedFromZonemap(t *testing.T) {
 	idx = shuffleByValueExtractedFromZonemap(rsp, zm, 4)
 	require.Equal(t, idx, uint64(1))
 }
+
+func TestRendezvousHashCN(t *testing.T) {
+	cns := []string{"cn-0", "cn-1", "cn-2", "cn-3", "cn-4"}
+	const numKeys = 100000
+
+	assign := func(cnIDs []string) []int {
+		owners := make([]int, numKeys)
+		for k := 0; k < numKeys; k++ {
+			owners[k] = RendezvousHashCN(uint64(k), cnIDs)
+		}
+		return owners
+	}
+
+	before := assign(cns)
+
+	// every key must land on a real CN
+	for _, owner := range before {
+		require.GreaterOrEqual(t, owner, 0)
+		require.Less(t, owner, len(cns))
+	}
+
+	// dropping one CN should only move the keys it used to own, not
+	// reshuffle everything the way a mod-N bucket index would.
+	remaining := []string{"cn-0", "cn-1", "cn-2", "cn-3"}
+	after := assign(remaining)
+
+	moved := 0
+	ownedByDroppedCN := 0
+	for k := 0; k < numKeys; k++ {
+		droppedCNWasOwner := cns[before[k]] == "cn-4"
+		if droppedCNWasOwner {
+			ownedByDroppedCN++
+			continue
+		}
+		if remaining[after[k]] != cns[before[k]] {
+			moved++
+		}
+	}
+	require.Zero(t, moved, "keys not owned by the dropped CN must not move")
+	require.InDelta(t, numKeys/len(cns), ownedByDroppedCN, float64(numKeys)/10)
+}
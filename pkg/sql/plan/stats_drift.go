@@ -0,0 +1,90 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var (
+	StatsDriftColDefs  []*plan.ColDef
+	StatsDriftColTypes []types.Type
+)
+
+func init() {
+	// stats_drift('db.t', snap_a, snap_b) reports, per column, how much the
+	// column's distribution moved between the two snapshots. snap_a/snap_b
+	// are names of existing `mo_catalog.mo_snapshots` entries.
+	StatsDriftColTypes = []types.Type{
+		types.New(types.T_varchar, types.MaxVarcharLen, 0), // column_name
+		types.New(types.T_float64, 0, 0),                   // null_ratio_a
+		types.New(types.T_float64, 0, 0),                   // null_ratio_b
+		types.New(types.T_float64, 0, 0),                   // null_ratio_drift
+		types.New(types.T_uint64, 0, 0),                    // ndv_a
+		types.New(types.T_uint64, 0, 0),                    // ndv_b
+		types.New(types.T_float64, 0, 0),                   // ndv_drift
+		types.New(types.T_float64, 0, 0),                   // histogram_distance
+	}
+
+	colNames := []string{
+		"column_name",
+		"null_ratio_a",
+		"null_ratio_b",
+		"null_ratio_drift",
+		"ndv_a",
+		"ndv_b",
+		"ndv_drift",
+		"histogram_distance",
+	}
+
+	StatsDriftColDefs = make([]*plan.ColDef, len(colNames))
+	for i, name := range colNames {
+		tp := StatsDriftColTypes[i]
+		StatsDriftColDefs[i] = &plan.ColDef{
+			Name: name,
+			Typ: plan.Type{
+				Id:          int32(tp.Oid),
+				Width:       tp.Width,
+				Scale:       tp.Scale,
+				NotNullable: true,
+			},
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+	}
+}
+
+func (builder *QueryBuilder) buildStatsDrift(_ *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, children []int32) int32 {
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "stats_drift",
+			},
+			Cols: StatsDriftColDefs,
+		},
+		BindingTags:     []int32{builder.genNewBindTag()},
+		Children:        children,
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx)
+}
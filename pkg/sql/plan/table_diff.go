@@ -0,0 +1,79 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var (
+	TableDiffColDefs  []*plan.ColDef
+	TableDiffColTypes []types.Type
+)
+
+func init() {
+	// table_diff('db.t', ts1, ts2) returns, for every primary key whose
+	// row changed between ts1 and ts2, one row describing that change.
+	TableDiffColTypes = []types.Type{
+		types.New(types.T_text, 0, 0),                      // pk_value
+		types.New(types.T_varchar, types.MaxVarcharLen, 0), // change_type: "insert", "update" or "delete"
+		types.New(types.T_text, 0, 0),                      // row_json
+	}
+
+	colNames := []string{
+		"pk_value",
+		"change_type",
+		"row_json",
+	}
+
+	TableDiffColDefs = make([]*plan.ColDef, len(colNames))
+	for i, name := range colNames {
+		tp := TableDiffColTypes[i]
+		TableDiffColDefs[i] = &plan.ColDef{
+			Name: name,
+			Typ: plan.Type{
+				Id:          int32(tp.Oid),
+				Width:       tp.Width,
+				Scale:       tp.Scale,
+				NotNullable: true,
+			},
+			Default: &plan.Default{
+				NullAbility:  false,
+				Expr:         nil,
+				OriginString: "",
+			},
+		}
+	}
+}
+
+func (builder *QueryBuilder) buildTableDiff(_ *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, children []int32) int32 {
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "table_diff",
+			},
+			Cols: TableDiffColDefs,
+		},
+		BindingTags:     []int32{builder.genNewBindTag()},
+		Children:        children,
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx)
+}
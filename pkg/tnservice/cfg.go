@@ -205,6 +205,14 @@ type Config struct {
 	// Under distributed deploy mode, cn,tn are independent os process.
 	// they have their own queryservice.
 	InStandalone bool
+
+	// Standby starts the store without claiming any TN shard or accepting
+	// txn traffic, so it stays passive until explicitly promoted via
+	// Service.Promote. It still registers with HAKeeper and starts the
+	// services (lock table allocator, shard server, query service, ...)
+	// that don't require owning a shard, so promotion doesn't have to wait
+	// on those. Default false.
+	Standby bool `toml:"standby"`
 }
 
 func (c *Config) Validate() error {
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"github.com/matrixorigin/matrixone/pkg/queryservice/client"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -135,6 +136,10 @@ type store struct {
 
 	addressMgr address.AddressManager
 
+	// standby tracks whether this store is currently refusing shard
+	// assignments (see Config.Standby and Promote).
+	standby atomic.Bool
+
 	config *util.ConfigData
 	// queryService for getting cache info from tnservice
 	queryService queryservice.QueryService
@@ -179,6 +184,7 @@ func NewService(
 	for _, opt := range opts {
 		opt(s)
 	}
+	s.standby.Store(cfg.Standby)
 	s.registerServices()
 	s.replicas = &sync.Map{}
 	s.stopper = stopper.NewStopper("dn-store",
@@ -222,8 +228,10 @@ func NewService(
 }
 
 func (s *store) Start() error {
-	if err := s.startTNShards(); err != nil {
-		return err
+	if !s.standby.Load() {
+		if err := s.startTNShards(); err != nil {
+			return err
+		}
 	}
 	if err := s.server.Start(); err != nil {
 		return err
@@ -273,6 +281,14 @@ func (s *store) StartTNReplica(shard metadata.TNShard) error {
 	return s.createReplica(shard)
 }
 
+// Promote takes the store out of standby mode. See Config.Standby.
+func (s *store) Promote() error {
+	if s.standby.CompareAndSwap(true, false) {
+		s.rt.Logger().Info("tn store promoted out of standby mode")
+	}
+	return nil
+}
+
 func (s *store) CloseTNReplica(shard metadata.TNShard) error {
 	return s.removeReplica(shard.ShardID)
 }
@@ -113,6 +113,14 @@ func (s *store) handleCommands(cmds []logservicepb.ScheduleCommand) {
 }
 
 func (s *store) handleAddReplica(cmd logservicepb.ScheduleCommand) {
+	if s.standby.Load() {
+		s.rt.Logger().Info(
+			"refusing add-replica command, store is in standby mode",
+			zap.String("command", cmd.LogString()),
+		)
+		return
+	}
+
 	shardID := cmd.ConfigChange.Replica.ShardID
 	logShardID := cmd.ConfigChange.Replica.LogShardID
 	replicaID := cmd.ConfigChange.Replica.ReplicaID
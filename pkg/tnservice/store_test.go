@@ -58,6 +58,32 @@ func TestAddReplica(t *testing.T) {
 	})
 }
 
+func TestStandbyRefusesAddReplicaUntilPromoted(t *testing.T) {
+	runTNStoreTest(t, func(s *store) {
+		s.standby.Store(true)
+
+		cmd := logservicepb.ScheduleCommand{
+			ServiceType: logservicepb.TNService,
+			ConfigChange: &logservicepb.ConfigChange{
+				ChangeType: logservicepb.AddReplica,
+				Replica: logservicepb.Replica{
+					LogShardID: 3,
+					ReplicaID:  2,
+					ShardID:    1,
+				},
+			},
+		}
+		s.handleCommands([]logservicepb.ScheduleCommand{cmd})
+		assert.Nil(t, s.getReplica(1))
+
+		assert.NoError(t, s.Promote())
+		s.handleCommands([]logservicepb.ScheduleCommand{cmd})
+		r := s.getReplica(1)
+		r.waitStarted()
+		assert.Equal(t, newTestTNShard(1, 2, 3), r.shard)
+	})
+}
+
 func TestHandleShutdown(t *testing.T) {
 	fn := func(s *store) {
 		cmd := logservicepb.ScheduleCommand{
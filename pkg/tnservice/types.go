@@ -48,6 +48,11 @@ type Service interface {
 	// CloseTNReplica close the DNShard replica.
 	CloseTNReplica(shard metadata.TNShard) error
 
+	// Promote takes a store started with Config.Standby out of standby mode,
+	// letting it accept shard-assignment commands from HAKeeper from now on.
+	// It is a no-op if the store isn't in standby mode.
+	Promote() error
+
 	// GetTaskService returns taskservice
 	GetTaskService() (taskservice.TaskService, bool)
 	// GetLockTableAllocator returns lock table allocator
@@ -279,6 +279,26 @@ type Workspace interface {
 	PPString() string
 
 	SetCloneTxn(snapshot int64)
+
+	// WriteStats reports the size of this workspace's pending, uncommitted
+	// writes: how many rows and bytes are buffered, and which tables they
+	// belong to. Meant for callers (e.g. a long-running ETL job) to decide
+	// whether to checkpoint or split work before hitting a commit-size limit.
+	WriteStats() WriteStats
+}
+
+// WriteStats is a snapshot of a Workspace's pending write-set size, see
+// Workspace.WriteStats.
+type WriteStats struct {
+	// RowCount is the total number of pending inserted/deleted rows across
+	// all tables.
+	RowCount int
+	// ByteSize is the approximate in-memory size, in bytes, of the pending
+	// writes.
+	ByteSize uint64
+	// Tables lists the distinct "database.table" names with pending writes,
+	// in no particular order.
+	Tables []string
 }
 
 // TxnOverview txn overview include meta and status
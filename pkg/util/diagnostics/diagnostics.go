@@ -0,0 +1,146 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diagnostics writes a best-effort postmortem bundle (memory
+// accounting plus whatever sections the caller knows about, such as the
+// statement that was running) to the ETL fileservice when a query hits OOM
+// or a CN panics, and prunes old bundles so the directory stays bounded.
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+// MaxBundles is the number of diagnostics bundles Prune keeps per node
+// before it starts deleting the oldest ones.
+const MaxBundles = 20
+
+const timestampFormat = "20060102_150405.000000"
+
+// Capture writes one diagnostics bundle to etlFS and prunes old bundles
+// afterward. reason identifies what triggered the capture (e.g. "oom",
+// "panic"); queryMp, if not nil, is the triggering query's own mpool, whose
+// per-tag report is included alongside the process-wide mpool stats.
+// sections are extra named text blocks the caller already has on hand, such
+// as the failing statement or a plan dump; keys become section headers.
+//
+// Capture never returns an error to a caller on the OOM/panic path that
+// can't do anything useful with it: it logs failures and returns nil so a
+// broken diagnostics dump never turns into a second, unrelated query
+// failure. The error return exists for tests.
+func Capture(
+	ctx context.Context,
+	etlFS fileservice.FileService,
+	serviceTyp, nodeId, reason string,
+	queryMp *mpool.MPool,
+	sections map[string]string,
+) error {
+	if etlFS == nil {
+		return nil
+	}
+
+	now := time.Now()
+	path := catalog.BuildDiagnosticsPath(serviceTyp, nodeId, now.UTC().Format(timestampFormat), reason)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "reason: %s\n", reason)
+	fmt.Fprintf(&buf, "time: %s\n", now.Format(time.RFC3339Nano))
+
+	fmt.Fprintf(&buf, "\n=== mpool (process-wide) ===\n%s", mpool.GlobalStats().Report("  "))
+	if queryMp != nil {
+		fmt.Fprintf(&buf, "\n=== mpool (query) ===\n%s", queryMp.Stats().Report("  "))
+	}
+
+	keys := make([]string, 0, len(sections))
+	for k := range sections {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "\n=== %s ===\n%s\n", k, sections[k])
+	}
+
+	writeCtx, cancel := context.WithTimeoutCause(context.Background(), time.Minute, moerr.CauseSaveDiagnosticsBundle)
+	defer cancel()
+	vec := fileservice.IOVector{
+		FilePath: path,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Data:   []byte(buf.String()),
+				Size:   int64(buf.Len()),
+			},
+		},
+	}
+	if err := etlFS.Write(writeCtx, vec); err != nil {
+		err = moerr.AttachCause(writeCtx, err)
+		logutil.Error("diagnostics.capture.write.failed", zap.String("path", path), zap.Error(err))
+		return nil
+	}
+	logutil.Info("diagnostics.capture.ok", zap.String("path", path), zap.String("reason", reason))
+
+	if err := Prune(ctx, etlFS, MaxBundles); err != nil {
+		logutil.Error("diagnostics.prune.failed", zap.Error(err))
+	}
+	return nil
+}
+
+// Prune keeps only the newest keep bundles under catalog.DiagnosticsDir and
+// deletes the rest. Bundle names are timestamp-prefixed (see
+// catalog.BuildDiagnosticsPath), so lexical order is chronological order.
+func Prune(ctx context.Context, etlFS fileservice.FileService, keep int) error {
+	if etlFS == nil {
+		return nil
+	}
+
+	var names []string
+	for entry, err := range etlFS.List(ctx, catalog.DiagnosticsDir) {
+		if err != nil {
+			return moerr.AttachCause(ctx, err)
+		}
+		if entry.IsDir {
+			continue
+		}
+		names = append(names, entry.Name)
+	}
+	if len(names) <= keep {
+		return nil
+	}
+
+	sort.Strings(names)
+	stale := names[:len(names)-keep]
+	paths := make([]string, len(stale))
+	for i, name := range stale {
+		paths[i] = path.Join(catalog.DiagnosticsDir, name)
+	}
+
+	pruneCtx, cancel := context.WithTimeoutCause(context.Background(), time.Minute, moerr.CausePruneDiagnostics)
+	defer cancel()
+	if err := etlFS.Delete(pruneCtx, paths...); err != nil {
+		return moerr.AttachCause(pruneCtx, err)
+	}
+	return nil
+}
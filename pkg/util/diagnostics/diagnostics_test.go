@@ -0,0 +1,91 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestETLFS(t *testing.T) fileservice.FileService {
+	fs, err := fileservice.NewMemoryFS(defines.ETLFileServiceName, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	return fs
+}
+
+func TestCapture(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestETLFS(t)
+
+	mp, err := mpool.NewMPool("diagnostics_test", 0, mpool.NoFixed)
+	require.NoError(t, err)
+
+	err = Capture(ctx, fs, "CN", "node1", "oom", mp, map[string]string{"statement": "select 1"})
+	assert.NoError(t, err)
+
+	var names []string
+	for entry, err := range fs.List(ctx, catalog.DiagnosticsDir) {
+		require.NoError(t, err)
+		names = append(names, entry.Name)
+	}
+	require.Len(t, names, 1)
+}
+
+func TestCapture_NilFileService(t *testing.T) {
+	// a nil etlFS (e.g. no ETL backend configured) must not panic or error.
+	assert.NoError(t, Capture(context.Background(), nil, "CN", "node1", "oom", nil, nil))
+}
+
+func TestPrune_KeepsOnlyNewest(t *testing.T) {
+	ctx := context.Background()
+	fs := newTestETLFS(t)
+
+	for i := 0; i < 5; i++ {
+		vec := fileservice.IOVector{
+			FilePath: catalog.BuildDiagnosticsPath("CN", "node1", timestampForIndex(i), "oom"),
+			Entries: []fileservice.IOEntry{
+				{Offset: 0, Data: []byte("x"), Size: 1},
+			},
+		}
+		require.NoError(t, fs.Write(ctx, vec))
+	}
+
+	require.NoError(t, Prune(ctx, fs, 2))
+
+	var names []string
+	for entry, err := range fs.List(ctx, catalog.DiagnosticsDir) {
+		require.NoError(t, err)
+		names = append(names, entry.Name)
+	}
+	require.Len(t, names, 2)
+	for _, name := range names {
+		kept := strings.Contains(name, timestampForIndex(3)) || strings.Contains(name, timestampForIndex(4))
+		assert.True(t, kept, "expected only the two newest bundles to survive, got %s", name)
+	}
+}
+
+func timestampForIndex(i int) string {
+	// distinct, lexically increasing timestamps so Prune's newest-N logic is
+	// exercised deterministically.
+	return "20260101_00000" + string(rune('0'+i))
+}
@@ -99,3 +99,33 @@ var (
 		[]string{"type"},
 	)
 )
+
+var (
+	MemPressureGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "mo",
+			Subsystem: "mem",
+			Name:      "pressure_ratio",
+			Help:      "Fraction of the cgroup/host memory limit the process RSS currently occupies, as last sampled by the memory pressure monitor.",
+		})
+
+	MemPressureEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "mem",
+			Name:      "pressure_event_total",
+			Help:      "Number of times the memory pressure monitor entered or exited its high-pressure state.",
+		},
+		[]string{"transition"},
+	)
+
+	MemPressureShrinkCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "mem",
+			Name:      "pressure_shrink_total",
+			Help:      "Number of times a given shrink hook was invoked by the memory pressure monitor.",
+		},
+		[]string{"hook"},
+	)
+)
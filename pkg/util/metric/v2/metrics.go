@@ -71,6 +71,9 @@ func initMemMetrics() {
 	registry.MustRegister(MallocCounter)
 	registry.MustRegister(MallocGauge)
 	registry.MustRegister(OffHeapInuseGauge)
+	registry.MustRegister(MemPressureGauge)
+	registry.MustRegister(MemPressureEventCounter)
+	registry.MustRegister(MemPressureShrinkCounter)
 }
 
 func initTaskMetrics() {
@@ -179,6 +182,8 @@ func initTxnMetrics() {
 	registry.MustRegister(txnSelectivityHistogram)
 	registry.MustRegister(txnColumnReadHistogram)
 	registry.MustRegister(txnReadSizeHistogram)
+	registry.MustRegister(TxnShuffleBucketSkewHistogram)
+	registry.MustRegister(shuffleSendRowCounter)
 
 	registry.MustRegister(starcountPathCounter)
 	registry.MustRegister(StarcountDurationHistogram)
@@ -253,6 +258,7 @@ func initFrontendMetrics() {
 func initPipelineMetrics() {
 	registry.MustRegister(PipelineServerDurationHistogram)
 	registry.MustRegister(pipelineStreamGauge)
+	registry.MustRegister(broadcastJoinBuildStrategyCounter)
 }
 
 func initLogServiceMetrics() {
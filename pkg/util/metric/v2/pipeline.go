@@ -36,4 +36,14 @@ var (
 			Help:      "Current number of stream connections to send messages to other CN (living senders).",
 		}, []string{"type"})
 	PipelineMessageSenderGauge = pipelineStreamGauge.WithLabelValues("living")
+
+	broadcastJoinBuildStrategyCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "pipeline",
+			Name:      "broadcast_join_build_strategy_total",
+			Help:      "Number of times a multi-CN broadcast join build side was compiled with each build strategy.",
+		}, []string{"strategy"})
+	BroadcastJoinBuildOnceCounter     = broadcastJoinBuildStrategyCounter.WithLabelValues("build_once")
+	BroadcastJoinBuildPerScopeCounter = broadcastJoinBuildStrategyCounter.WithLabelValues("build_per_scope")
 )
@@ -0,0 +1,127 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+)
+
+// shuffleLocalityStatsCapacity bounds how many distinct queries' locality
+// stats are kept at once. The global TxnShuffleLocalSendRowCounter/
+// TxnShuffleRemoteSendRowCounter metrics tell you locality dropped cluster
+// wide, but not which query caused it; this LRU keeps enough recent queries
+// around to answer that without growing unbounded on a busy CN.
+const shuffleLocalityStatsCapacity = 256
+
+// QueryLocalityStat is one query's aggregated shuffle locality, as seen by
+// this CN's Dispatch operators.
+type QueryLocalityStat struct {
+	QueryID    string `json:"query_id"`
+	LocalRows  int64  `json:"local_rows"`
+	RemoteRows int64  `json:"remote_rows"`
+}
+
+// LocalityRatio is the fraction of shuffled rows this query sent to a local
+// (same-CN) receiver. 1.0 is perfectly local, 0.0 is fully remote.
+func (s QueryLocalityStat) LocalityRatio() float64 {
+	total := s.LocalRows + s.RemoteRows
+	if total == 0 {
+		return 1
+	}
+	return float64(s.LocalRows) / float64(total)
+}
+
+type shuffleLocalityLRU struct {
+	mu       sync.Mutex
+	capacity int
+	lruList  *list.List
+	byQuery  map[string]*list.Element
+}
+
+func newShuffleLocalityLRU(capacity int) *shuffleLocalityLRU {
+	return &shuffleLocalityLRU{
+		capacity: capacity,
+		lruList:  list.New(),
+		byQuery:  make(map[string]*list.Element),
+	}
+}
+
+func (l *shuffleLocalityLRU) record(queryID string, localRows, remoteRows int64) {
+	if queryID == "" {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.byQuery[queryID]; ok {
+		stat := element.Value.(*QueryLocalityStat)
+		stat.LocalRows += localRows
+		stat.RemoteRows += remoteRows
+		l.lruList.MoveToFront(element)
+		return
+	}
+
+	element := l.lruList.PushFront(&QueryLocalityStat{
+		QueryID:    queryID,
+		LocalRows:  localRows,
+		RemoteRows: remoteRows,
+	})
+	l.byQuery[queryID] = element
+
+	if l.lruList.Len() > l.capacity {
+		oldest := l.lruList.Back()
+		delete(l.byQuery, oldest.Value.(*QueryLocalityStat).QueryID)
+		l.lruList.Remove(oldest)
+	}
+}
+
+// top returns up to n tracked queries, worst locality ratio first.
+func (l *shuffleLocalityLRU) top(n int) []QueryLocalityStat {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats := make([]QueryLocalityStat, 0, l.lruList.Len())
+	for element := l.lruList.Front(); element != nil; element = element.Next() {
+		stats = append(stats, *element.Value.(*QueryLocalityStat))
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].LocalityRatio() < stats[j].LocalityRatio()
+	})
+	if n >= 0 && n < len(stats) {
+		stats = stats[:n]
+	}
+	return stats
+}
+
+var globalShuffleLocalityStats = newShuffleLocalityLRU(shuffleLocalityStatsCapacity)
+
+// RecordShuffleLocalityStats attributes localRows/remoteRows sent by a
+// shuffle Dispatch operator to the query that produced them, for
+// mo_ctl("cn", "shuffle_monitor", "top") to surface later. Bounded by
+// shuffleLocalityStatsCapacity; once full, the least recently touched query
+// is evicted to make room.
+func RecordShuffleLocalityStats(queryID string, localRows, remoteRows int64) {
+	globalShuffleLocalityStats.record(queryID, localRows, remoteRows)
+}
+
+// TopWorstShuffleLocality returns up to n queries tracked on this CN with
+// the worst shuffle locality ratio, worst first. n < 0 means no limit.
+func TopWorstShuffleLocality(n int) []QueryLocalityStat {
+	return globalShuffleLocalityStats.top(n)
+}
@@ -537,6 +537,43 @@ var (
 	TxnDiskReadSizeHistogram = txnReadSizeHistogram.WithLabelValues("disk")
 )
 
+// Shuffle bucket balance metrics: track how evenly a shuffle operator's split
+// points distributed rows across its buckets
+var (
+	// TxnShuffleBucketSkewHistogram is the ratio of the most-loaded bucket's row
+	// count to the average bucket row count for one shuffle operator instance,
+	// observed when that instance finishes sending all its data. 1.0 means
+	// perfectly even; the higher it is, the more skewed the split points were.
+	TxnShuffleBucketSkewHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mo",
+			Subsystem: "txn",
+			Name:      "shuffle_bucket_skew",
+			Help:      "Bucketed histogram of shuffle bucket row count skew (max bucket rows / average bucket rows).",
+			Buckets:   prometheus.ExponentialBuckets(1, 1.5, 15),
+		})
+
+	// shuffleSendRowCounter counts rows a Dispatch operator handed to a
+	// shuffle bucket's matched receiver, split by whether that receiver lived
+	// on the same CN (no network hop) or a different one. Only the
+	// single-target ShuffleToRegIndex routing path (the common case) is
+	// counted; ShuffleToLocalMatchedReg is local by construction and
+	// ShuffleToMultiMatchedReg can fan one bucket to several receivers, so
+	// neither maps cleanly onto a single local/remote row count.
+	shuffleSendRowCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "txn",
+			Name:      "shuffle_send_rows_total",
+			Help:      "Total rows a shuffle Dispatch operator sent to a matched receiver, by locality.",
+		}, []string{"locality"})
+
+	// TxnShuffleLocalSendRowCounter and TxnShuffleRemoteSendRowCounter
+	// together give the shuffle locality rate: local / (local + remote).
+	TxnShuffleLocalSendRowCounter  = shuffleSendRowCounter.WithLabelValues("local")
+	TxnShuffleRemoteSendRowCounter = shuffleSendRowCounter.WithLabelValues("remote")
+)
+
 // StarCount (SELECT COUNT(*) optimization) metrics
 var (
 	starcountPathCounter = prometheus.NewCounterVec(
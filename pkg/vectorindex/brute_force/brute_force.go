@@ -15,10 +15,10 @@
 package brute_force
 
 import (
+	"container/heap"
 	"context"
 	"fmt"
 	"runtime"
-	"slices"
 
 	"github.com/matrixorigin/matrixone/pkg/common/concurrent"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -29,9 +29,32 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vectorindex/metric"
 	"github.com/matrixorigin/matrixone/pkg/vectorindex/sqlexec"
 	usearch "github.com/unum-cloud/usearch/golang"
-	"github.com/viterin/partial"
 )
 
+// goBruteForceBlockSize is the number of dataset vectors scanned per batch
+// distance call in GoBruteForceIndex.Search. It is sized to keep a block's
+// scratch distance buffer cache-resident without adding much per-block
+// overhead.
+const goBruteForceBlockSize = 256
+
+// pushBounded maintains a max-heap of at most k SearchResults: the closest k
+// neighbours seen so far, with the current farthest of those k at the root.
+// This lets Search keep only O(k) results in memory per query instead of
+// materializing a full query x dataset distance matrix before sorting it.
+func pushBounded(h *vectorindex.SearchResultMaxHeap, sr *vectorindex.SearchResult, k int) {
+	if k <= 0 {
+		return
+	}
+	if h.Len() < k {
+		heap.Push(h, sr)
+		return
+	}
+	if farthest := (*h)[0]; sr.Distance < farthest.GetDistance() {
+		heap.Pop(h)
+		heap.Push(h, sr)
+	}
+}
+
 type UsearchBruteForceIndex[T types.RealNumbers] struct {
 	Dataset      []T // flattend vector
 	Metric       usearch.Metric
@@ -202,78 +225,65 @@ func (idx *GoBruteForceIndex[T]) Search(proc *sqlexec.SqlProcess, _queries any,
 	if err != nil {
 		return nil, nil, err
 	}
+	// L2Distance and L2sqDistance both resolve to L2DistanceSq, so the
+	// batched kernel below stays usable for either.
+	isL2Sq := idx.Metric == metric.Metric_L2Distance || idx.Metric == metric.Metric_L2sqDistance
 
 	nthreads := rt.NThreads
-
-	// datasize * nqueries
 	nqueries := len(queries)
-	ndataset := len(idx.Dataset)
 
-	// create distance matric
-	results := make([][]vectorindex.SearchResult, nqueries)
-	for i := range results {
-		results[i] = make([]vectorindex.SearchResult, ndataset)
+	limit := int(rt.Limit)
+	if limit > len(idx.Dataset) {
+		limit = len(idx.Dataset)
 	}
 
+	keys64 := make([]int64, nqueries*limit)
+	distances = make([]float64, nqueries*limit)
+
 	exec := concurrent.NewThreadPoolExecutor(int(nthreads))
 	err = exec.Execute(
 		proc.GetContext(),
 		nqueries,
 		func(ctx context.Context, thread_id int, start, end int) (err2 error) {
 			subqueries := queries[start:end:end]
-			subresults := results[start:end:end]
+			block := make([]T, goBruteForceBlockSize)
+
 			for k, q := range subqueries {
 				if k%100 == 0 && ctx.Err() != nil {
 					return ctx.Err()
 				}
 
-				for j := range idx.Dataset {
-					dist, err2 := distfn(q, idx.Dataset[j])
-					if err2 != nil {
-						return err2
+				topk := make(vectorindex.SearchResultMaxHeap, 0, limit)
+
+				for base := 0; base < len(idx.Dataset); base += goBruteForceBlockSize {
+					blk := idx.Dataset[base:min(base+goBruteForceBlockSize, len(idx.Dataset))]
+
+					if isL2Sq {
+						out := block[:len(blk)]
+						if err2 = metric.L2DistanceSqBatch(q, blk, out); err2 != nil {
+							return err2
+						}
+						for j, dist := range out {
+							pushBounded(&topk, &vectorindex.SearchResult{Id: int64(base + j), Distance: float64(dist)}, limit)
+						}
+					} else {
+						for j, v := range blk {
+							dist, err3 := distfn(q, v)
+							if err3 != nil {
+								return err3
+							}
+							pushBounded(&topk, &vectorindex.SearchResult{Id: int64(base + j), Distance: float64(dist)}, limit)
+						}
 					}
-					subresults[k][j].Id = int64(j)
-					subresults[k][j].Distance = float64(dist)
-				}
-			}
-			return
-		})
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	cmpfn := func(a, b vectorindex.SearchResult) int {
-		if a.Distance < b.Distance {
-			return -1
-		} else if a.Distance == b.Distance {
-			return 0
-		}
-		return 1
-	}
-
-	// get min
-	keys64 := make([]int64, nqueries*int(rt.Limit))
-	distances = make([]float64, nqueries*int(rt.Limit))
-	err = exec.Execute(
-		proc.GetContext(),
-		nqueries,
-		func(ctx context.Context, thread_id int, start, end int) (err2 error) {
-			subresults := results[start:end:end]
-			for j := range subresults {
-				if j%100 == 0 && ctx.Err() != nil {
-					return ctx.Err()
 				}
 
-				if rt.Limit == 1 {
-					// min
-					first := slices.MinFunc(subresults[j], cmpfn)
-					subresults[j][0] = first
-
-				} else {
-					// partial sort
-					partial.SortFunc(subresults[j], int(rt.Limit), cmpfn)
-
+				// topk is a max-heap, so pop from the back to land the
+				// results in ascending-distance order.
+				qi := start + k
+				for j := topk.Len() - 1; j >= 0; j-- {
+					sr := heap.Pop(&topk).(*vectorindex.SearchResult)
+					keys64[qi*limit+j] = sr.Id
+					distances[qi*limit+j] = sr.Distance
 				}
 			}
 			return
@@ -282,12 +292,5 @@ func (idx *GoBruteForceIndex[T]) Search(proc *sqlexec.SqlProcess, _queries any,
 		return nil, nil, err
 	}
 
-	for i := 0; i < nqueries; i++ {
-		for j := 0; j < int(rt.Limit); j++ {
-			keys64[i*int(rt.Limit)+j] = results[i][j].Id
-			distances[i*int(rt.Limit)+j] = results[i][j].Distance
-		}
-	}
-
 	return keys64, distances, nil
 }
@@ -94,6 +94,27 @@ func L2DistanceSq[T types.RealNumbers](p, q []T) (T, error) {
 	return sum, nil
 }
 
+// L2DistanceSqBatch computes the squared L2 distance between query and every
+// vector in dataset, writing one result per dataset entry into out. It exists
+// so a brute-force scan can walk the dataset in blocks through a single call
+// instead of paying per-call overhead for every dataset vector, while still
+// going through the same loop-unrolled L2DistanceSq underneath.
+func L2DistanceSqBatch[T types.RealNumbers](query []T, dataset [][]T, out []T) error {
+	if len(dataset) != len(out) {
+		return moerr.NewInternalErrorNoCtx("dataset and out length not matched")
+	}
+
+	for i, v := range dataset {
+		dist, err := L2DistanceSq(query, v)
+		if err != nil {
+			return err
+		}
+		out[i] = dist
+	}
+
+	return nil
+}
+
 // L1Distance calculates the L1 (Manhattan) distance between two vectors.
 /*
 func L1Distance[T types.RealNumbers](v1, v2 []T) (T, error) {
@@ -495,6 +495,24 @@ func Test_L2DistanceSq(t *testing.T) {
 	}
 }
 
+func Test_L2DistanceSqBatch(t *testing.T) {
+	query := []float64{1, 2, 3, 4}
+	dataset := [][]float64{
+		{1, 2, 4, 5},
+		{10, 20, 30, 40},
+		{1, 2, 3, 4},
+	}
+	want := []float64{2, 1299, 0}
+
+	out := make([]float64, len(dataset))
+	err := L2DistanceSqBatch(query, dataset, out)
+	require.NoError(t, err)
+	require.Equal(t, want, out)
+
+	err = L2DistanceSqBatch(query, dataset, make([]float64, len(dataset)-1))
+	require.Error(t, err)
+}
+
 func Test_AngularDistance(t *testing.T) {
 	type args struct {
 		v1 []float64
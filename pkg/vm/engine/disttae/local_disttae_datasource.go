@@ -138,6 +138,12 @@ type LocalDisttaeDataSource struct {
 
 	filterZM        objectio.ZoneMap
 	tombstonePolicy engine.TombstoneApplyPolicy
+
+	// tombstoneCache holds, per block, the full persisted-tombstone delete
+	// bitmap already computed by applyPStateTombstoneObjects, so repeated
+	// point/range reads against the same block within this data source's
+	// lifetime don't re-scan the tombstone objects from storage.
+	tombstoneCache map[objectio.Blockid]objectio.Bitmap
 }
 
 func (ls *LocalDisttaeDataSource) String() string {
@@ -361,6 +367,10 @@ func (ls *LocalDisttaeDataSource) Close() {
 		ls.pStateRows.insIter.Close()
 		ls.pStateRows.insIter = nil
 	}
+	for bid, mask := range ls.tombstoneCache {
+		mask.Release()
+		delete(ls.tombstoneCache, bid)
+	}
 }
 
 func (ls *LocalDisttaeDataSource) Next(
@@ -1261,30 +1271,40 @@ func (ls *LocalDisttaeDataSource) applyPStateTombstoneObjects(
 		return offsets, nil
 	}
 
-	var iter objectio.ObjectIter
-	getTombstone := func() (*objectio.ObjectStats, error) {
-		var err error
-		if iter == nil {
-			if iter, err = ls.pState.NewObjectsIter(
-				ls.snapshotTS, true, true,
-			); err != nil {
-				return nil, err
+	// PXU TODO: handle len(offsets) < 10 or 20, 30?
+	if len(offsets) == 1 {
+		// A block already in the cache (from an earlier range/whole-block
+		// lookup against the same block in this scan) answers a point
+		// lookup for free, without re-reading the tombstone objects.
+		if mask, ok := ls.tombstoneCache[*bid]; ok {
+			if mask.Contains(uint64(offsets[0])) {
+				return nil, nil
 			}
+			return offsets, nil
 		}
-		if iter.Next() {
-			entry := iter.Entry()
-			return &entry.ObjectStats, nil
-		}
-		return nil, nil
-	}
-	defer func() {
-		if iter != nil {
-			iter.Close()
+
+		var iter objectio.ObjectIter
+		getTombstone := func() (*objectio.ObjectStats, error) {
+			var err error
+			if iter == nil {
+				if iter, err = ls.pState.NewObjectsIter(
+					ls.snapshotTS, true, true,
+				); err != nil {
+					return nil, err
+				}
+			}
+			if iter.Next() {
+				entry := iter.Entry()
+				return &entry.ObjectStats, nil
+			}
+			return nil, nil
 		}
-	}()
+		defer func() {
+			if iter != nil {
+				iter.Close()
+			}
+		}()
 
-	// PXU TODO: handle len(offsets) < 10 or 20, 30?
-	if len(offsets) == 1 {
 		rowid := objectio.NewRowid(bid, uint32(offsets[0]))
 		deleted, err := ioutil.IsRowDeleted(
 			ls.ctx,
@@ -1302,32 +1322,100 @@ func (ls *LocalDisttaeDataSource) applyPStateTombstoneObjects(
 		return offsets, nil
 	}
 
-	release := func() {}
-	if deletedRows == nil {
-		bm := objectio.GetReusableBitmap()
-		deletedRows = &bm
-		release = bm.Release
+	mask, err := ls.blockTombstones(bid)
+	if err != nil {
+		return nil, err
 	}
-	defer release()
 
+	if deletedRows != nil {
+		deletedRows.Or(mask)
+	}
+	if offsets == nil {
+		return offsets, nil
+	}
+
+	// offsets is a narrow row range rather than the whole block: only the
+	// slice of the mask intersecting it is relevant, so shrink it before
+	// checking instead of testing every offset against the full-block mask.
+	lo, hi := uint64(offsets[0]), uint64(offsets[len(offsets)-1])+1
+	ranged := mask.Slice(lo, hi)
+	defer ranged.Release()
+
+	offsets = readutil.RemoveIf(offsets, func(t int64) bool {
+		return ranged.Contains(uint64(t))
+	})
+
+	return offsets, nil
+}
+
+// blockTombstones returns the full persisted-tombstone delete bitmap for
+// bid, computing it on first use and caching it for the remaining lifetime
+// of this data source. Successive point/range reads against the same block
+// within one scan (common on heavily-deleted blocks) reuse the cached
+// bitmap instead of re-scanning the tombstone objects from storage.
+func (ls *LocalDisttaeDataSource) blockTombstones(bid *objectio.Blockid) (objectio.Bitmap, error) {
+	if mask, ok := ls.tombstoneCache[*bid]; ok {
+		return mask, nil
+	}
+
+	var iter objectio.ObjectIter
+	getTombstone := func() (*objectio.ObjectStats, error) {
+		var err error
+		if iter == nil {
+			if iter, err = ls.pState.NewObjectsIter(
+				ls.snapshotTS, true, true,
+			); err != nil {
+				return nil, err
+			}
+		}
+		if iter.Next() {
+			entry := iter.Entry()
+			return &entry.ObjectStats, nil
+		}
+		return nil, nil
+	}
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
+	mask := objectio.GetReusableBitmap()
 	if err := ioutil.GetTombstonesByBlockId(
 		ls.ctx,
 		&ls.snapshotTS,
 		bid,
 		getTombstone,
-		deletedRows,
+		&mask,
 		ls.fs,
 	); err != nil {
-		return nil, err
+		mask.Release()
+		return objectio.Bitmap{}, err
 	}
 
-	offsets = readutil.RemoveIf(offsets, func(t int64) bool {
-		return deletedRows.Contains(uint64(t))
-	})
-
-	return offsets, nil
+	if ls.tombstoneCache == nil {
+		ls.tombstoneCache = make(map[objectio.Blockid]objectio.Bitmap)
+	}
+	ls.tombstoneCache[*bid] = mask
+	return mask, nil
 }
 
+// batchPrefetch issues read-ahead IO for the next readutil.BatchPrefetchSize
+// blocks in ls.rangeSlice, deduplicated by object so a multi-block object is
+// only prefetched once. This already gives the reader a "decode block N,
+// have block N+1 warm" pipeline, but it is whole-object/file level, not
+// column level: ioutil.Prefetch takes only blk.MetaLocation() and has no
+// notion of which columns the caller actually needs, so seqNums is accepted
+// here but unused. Narrowing the prefetch to just the columns in seqNums
+// would mean threading column selection through PrefetchParams and
+// IoPipeline.Prefetch (pkg/objectio/ioutil/prefetch.go, pipeline.go) down to
+// ObjectReader, and a per-query prefetch budget would need a new throttling
+// point shared across every LocalDisttaeDataSource a query's readers spawn
+// (BuildReaders constructs one per shard, each with its own independent
+// rc.batchPrefetchCursor). Both are real, separable changes to the IO
+// pipeline rather than something that can be bolted onto this method
+// without risking over-eager prefetch thrashing the fileservice cache for
+// concurrent queries.
 func (ls *LocalDisttaeDataSource) batchPrefetch(seqNums []uint16) {
 	if ls.rc.prefetchDisabled {
 		return
@@ -1195,6 +1195,15 @@ func (p *PartitionState) IsEmpty() bool {
 	return p.start == types.MaxTs()
 }
 
+// HasNoVisibleData reports whether the state currently holds neither data
+// objects nor in-memory rows. It's a cheap, conservative O(1) check (no
+// snapshot-visibility filtering): callers use it to short-circuit work that
+// would otherwise scan for data that provably isn't there at any snapshot,
+// since a state with no objects and no rows can't produce any at all.
+func (p *PartitionState) HasNoVisibleData() bool {
+	return p.dataObjectsNameIndex.Len() == 0 && p.rows.Len() == 0
+}
+
 func (p *PartitionState) LogAllRowEntry() string {
 	var buf bytes.Buffer
 	_ = p.ScanRows(false, func(entry *RowEntry) (bool, error) {
@@ -83,6 +83,23 @@ func TestTruncate(t *testing.T) {
 	assert.Equal(t, 1, partition.dataObjectTSIndex.Len())
 }
 
+func TestHasNoVisibleData(t *testing.T) {
+	state := NewPartitionState("", true, 42, false)
+	require.True(t, state.HasNoVisibleData())
+
+	addObject(state, types.BuildTS(1, 0), types.TS{})
+	require.False(t, state.HasNoVisibleData())
+
+	state2 := NewPartitionState("", true, 43, false)
+	rid := types.BuildTestRowid(1, 1)
+	state2.rows.Set(&RowEntry{
+		BlockID: rid.CloneBlockID(),
+		RowID:   rid,
+		Time:    types.BuildTS(1, 0),
+	})
+	require.False(t, state2.HasNoVisibleData())
+}
+
 func addObject(p *PartitionState, create, delete types.TS) {
 	blkID := objectio.NewBlockid(objectio.NewSegmentid(), 0, 0)
 	objShortName := objectio.ShortName(blkID)
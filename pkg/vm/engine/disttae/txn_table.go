@@ -1040,6 +1040,19 @@ func (tbl *txnTable) doRanges(ctx context.Context, rangesParam engine.RangesPara
 		}
 	}
 
+	// Nothing committed to S3 and nothing uncommitted either: there is
+	// provably no data this snapshot could see, so skip the object/zonemap
+	// scan in rangesOnePart entirely. This matters for polling workloads
+	// that repeatedly query tables with no or fully-pruned data.
+	if part != nil && len(uncommittedObjects) == 0 && part.HasNoVisibleData() {
+		blklist := readutil.NewBlockListRelationData(
+			0,
+			readutil.WithPartitionState(part))
+		blklist.SetBlockList(blocks)
+		data = blklist
+		return
+	}
+
 	if err = tbl.rangesOnePart(
 		ctx,
 		part,
@@ -2182,6 +2195,10 @@ func (tbl *txnTable) buildLocalDataSource(
 //   - ranges: Byte array representing the data range to read.
 //   - orderedScan: Whether to scan the data in order.
 //   - txnOffset: Transaction offset used to specify the starting position for reading data.
+//
+// relData.Split(newNum) below splits blocks into num equal-count static
+// shards, one per reader, so skewed object sizes can leave some readers
+// idle while others are still scanning.
 func (tbl *txnTable) BuildReaders(
 	ctx context.Context,
 	p any,
@@ -2563,7 +2580,7 @@ func (tbl *txnTable) PKPersistedBetween(
 			blk.MetaLocation(),
 			cacheVectors,
 			tbl.proc.Load().GetMPool(),
-			fileservice.Policy(0),
+			objectio.PolicyForTable(tbl.tableId, fileservice.Policy(0)),
 		)
 		if err != nil {
 			return true, err
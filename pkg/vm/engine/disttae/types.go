@@ -565,6 +565,28 @@ func (txn *Transaction) PPString() string {
 		stringifySlice(txn.transfer.timestamps, func(a any) string { t := a.(timestamp.Timestamp); return t.DebugString() }))
 }
 
+func (txn *Transaction) WriteStats() client.WriteStats {
+	txn.Lock()
+	defer txn.Unlock()
+
+	tables := make(map[string]struct{}, len(txn.writes))
+	stats := client.WriteStats{}
+	for _, e := range txn.writes {
+		if e.bat == nil {
+			continue
+		}
+		stats.RowCount += e.bat.RowCount()
+		stats.ByteSize += uint64(e.bat.Size())
+		tables[e.databaseName+"."+e.tableName] = struct{}{}
+	}
+
+	stats.Tables = make([]string, 0, len(tables))
+	for name := range tables {
+		stats.Tables = append(stats.Tables, name)
+	}
+	return stats
+}
+
 func (txn *Transaction) StartStatement() {
 	if txn.startStatementCalled {
 		logutil.Fatal("BUG: StartStatement called twice")
@@ -213,6 +213,17 @@ func CompileFilterExprs(
 	return
 }
 
+// CompileFilterExpr turns a scalar filter expression into block/object-level
+// zonemap and bloom-filter probes. It only recognizes "physical column op
+// const" comparisons (see mustColConstValueFromBinaryFuncExpr): a predicate
+// like json_extract(col, '$.path') > 0.9 has no plain column on either side,
+// so it falls through to canCompile = false below and the caller scans every
+// block. Pruning such expressions by their JSON path would need either a
+// materialized, zonemap-backed column for that path (generated/computed
+// columns are parsed but rejected by the planner in this tree today) or a
+// functional index whose key part is an expression (index_column in the
+// grammar only accepts a plain column name). Until one of those lands, JSON
+// path predicates are expected to miss pruning here.
 func CompileFilterExpr(
 	expr *plan.Expr,
 	tableDef *plan.TableDef,
@@ -329,6 +340,21 @@ func CompileFilterExpr(
 			}
 
 		case "and":
+			// Each conjunct is compiled and pruned independently against its
+			// own column's zonemap, then the per-column results are ANDed
+			// together below. For correlated predicates like a>10 AND b<5
+			// where a and b are correlated, this over-selects relative to a
+			// composite (sorted-prefix) zonemap built over (a, b) together:
+			// a block can pass both single-column probes while still having
+			// no row satisfying the conjunction jointly. Adding that would
+			// mean a new on-disk index type generated at flush time for
+			// declared column groups (objectio has no such index today; see
+			// pkg/objectio/writer.go for what gets written alongside the
+			// per-column zonemap), a way to declare which column pairs get
+			// one, and a new BlockFilterOp variant that consumes multiple
+			// columns at once instead of the current per-column closures.
+			// That's a new index type plus DDL surface, not a change this
+			// function's existing per-column op composition can absorb.
 			highSelectivityHint = true
 			fastOps := make([]FastFilterOp, 0, len(exprImpl.F.Args))
 			loadOps := make([]LoadOp, 0, len(exprImpl.F.Args))
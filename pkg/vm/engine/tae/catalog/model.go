@@ -117,6 +117,10 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *Schema, err erro
 					schema.Createsql = property.Value
 				case pkgcatalog.PropSchemaExtra:
 					schema.Extra = api.MustUnmarshalTblExtra([]byte(property.Value))
+				case pkgcatalog.SystemRelAttr_AppendOnly:
+					schema.AppendOnly = strings.EqualFold(property.Value, "true")
+				case pkgcatalog.SystemRelAttr_ColumnGroups:
+					schema.ColumnGroups = property.Value
 				default:
 				}
 			}
@@ -196,6 +200,12 @@ func SchemaToDefs(schema *Schema) (defs []engine.TableDef, err error) {
 		Key:   pkgcatalog.PropSchemaExtra,
 		Value: string(api.MustMarshalTblExtra(schema.Extra)),
 	})
+	if schema.AppendOnly {
+		pro.Properties = append(pro.Properties, engine.Property{
+			Key:   pkgcatalog.SystemRelAttr_AppendOnly,
+			Value: "true",
+		})
+	}
 	defs = append(defs, pro)
 
 	return
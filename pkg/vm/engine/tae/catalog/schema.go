@@ -141,6 +141,23 @@ type Schema struct {
 	Createsql      string
 	View           string
 	Constraint     []byte
+	// AppendOnly marks a table whose keys are declared monotonically
+	// increasing (e.g. event logs), set via PROPERTIES('append_only'='true').
+	// It lets the dedup path skip the persisted-data check for insert
+	// batches that are entirely above the table's tracked watermark.
+	AppendOnly bool
+
+	// ColumnGroups is the raw "group1:col1,col2;group2:col3" declaration
+	// set via PROPERTIES('column_groups'=...). Columns named in a group
+	// are meant to be written and scanned as a separate object family
+	// from the table's default group, so a scan touching only the
+	// default group never reads the grouped columns' bytes.
+	//
+	// NOTE: only the declaration is validated and exposed here
+	// (ColumnGroup/HasColumnGroups below); the storage layer does not
+	// yet split writes or scans by group, so today every group still
+	// lives in the same object family as the default one.
+	ColumnGroups string
 
 	// do not send to cn
 	DeprecatedBlockMaxRows uint32
@@ -149,10 +166,11 @@ type Schema struct {
 	Extra                     *apipb.SchemaExtra
 
 	// do not write down, reconstruct them when reading
-	NameMap    map[string]int // name(letter case: origin) -> logical idx
-	SeqnumMap  map[uint16]int // seqnum -> logical idx
-	SortKey    *SortKey
-	PhyAddrKey *ColDef
+	NameMap        map[string]int // name(letter case: origin) -> logical idx
+	SeqnumMap      map[uint16]int // seqnum -> logical idx
+	SortKey        *SortKey
+	PhyAddrKey     *ColDef
+	columnGroupMap map[string]string // column name -> group name, parsed from ColumnGroups
 
 	isSecondaryIndexTable bool
 }
@@ -165,6 +183,9 @@ func NewEmptySchema(name string) *Schema {
 		SeqnumMap: make(map[uint16]int),
 		Extra:     &apipb.SchemaExtra{},
 	}
+	// BlockMaxRows caps how large an anode (txnimpl's in-memory appendable
+	// node) can grow before a flush. It's the same constant for every
+	// schema regardless of the table's ingest rate.
 	schema.Extra.BlockMaxRows = objectio.BlockMaxRows
 	schema.Extra.ObjectMaxBlocks = uint32(options.DefaultBlocksPerObject)
 	return schema
@@ -967,9 +988,61 @@ func (s *Schema) Finalize(withoutPhyAddr bool) (err error) {
 		panic("schema: multiple sort keys")
 	}
 	s.isSecondaryIndexTable = strings.Contains(s.Name, "__mo_index_secondary_")
+	if s.ColumnGroups != "" {
+		if s.columnGroupMap, err = parseColumnGroups(s.ColumnGroups, s.NameMap); err != nil {
+			return err
+		}
+	}
 	return
 }
 
+// parseColumnGroups parses a "group1:col1,col2;group2:col3" declaration
+// into a column name -> group name map, rejecting unknown columns and
+// columns claimed by more than one group.
+func parseColumnGroups(decl string, nameMap map[string]int) (map[string]string, error) {
+	colGroup := make(map[string]string)
+	for _, group := range strings.Split(decl, ";") {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			continue
+		}
+		parts := strings.SplitN(group, ":", 2)
+		if len(parts) != 2 {
+			return nil, moerr.NewInvalidInputNoCtxf("column_groups: bad group declaration %q", group)
+		}
+		groupName := strings.TrimSpace(parts[0])
+		if groupName == "" {
+			return nil, moerr.NewInvalidInputNoCtxf("column_groups: empty group name in %q", group)
+		}
+		for _, col := range strings.Split(parts[1], ",") {
+			col = strings.TrimSpace(col)
+			if col == "" {
+				continue
+			}
+			if _, ok := nameMap[col]; !ok {
+				return nil, moerr.NewInvalidInputNoCtxf("column_groups: unknown column %q", col)
+			}
+			if existing, ok := colGroup[col]; ok {
+				return nil, moerr.NewInvalidInputNoCtxf("column_groups: column %q claimed by both %q and %q", col, existing, groupName)
+			}
+			colGroup[col] = groupName
+		}
+	}
+	return colGroup, nil
+}
+
+// ColumnGroup returns the storage column group colName was declared into
+// via ColumnGroups, or "" if it stayed in the table's default group.
+func (s *Schema) ColumnGroup(colName string) string {
+	return s.columnGroupMap[colName]
+}
+
+// HasColumnGroups reports whether this schema declares any non-default
+// column group.
+func (s *Schema) HasColumnGroups() bool {
+	return len(s.columnGroupMap) > 0
+}
+
 // GetColIdx returns column index for the given column name
 // if found, otherwise returns -1.
 func (s *Schema) GetColIdx(attr string) int {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/tidwall/btree"
 )
@@ -59,6 +61,90 @@ type TableEntry struct {
 
 	dataObjects      *ObjectList
 	tombstoneObjects *ObjectList
+
+	// dedupWatermark tracks the highest sort key committed into this table so
+	// far, for AppendOnly tables. It's an in-memory fast path only: it is not
+	// persisted and is empty again after a restart, at which point dedup falls
+	// back to checking persisted data as usual until the watermark warms back up.
+	dedupWatermark struct {
+		sync.RWMutex
+		zm index.ZM
+	}
+
+	// lineage is an in-memory, append-only log of branch/merge/snapshot/
+	// clone/restore events recorded against this table, queryable like
+	// "git log" via Lineage(). Like dedupWatermark, it is a fast-path
+	// convenience only: it is not persisted and is empty again after a
+	// restart. A durable, SQL-queryable mo_catalog.mo_table_versions
+	// system table is the natural next step but is out of scope here.
+	lineage struct {
+		sync.RWMutex
+		events []LineageEvent
+	}
+}
+
+// LineageEventKind identifies the kind of lifecycle event recorded in a
+// table's in-memory lineage log (TableEntry.lineage).
+type LineageEventKind string
+
+const (
+	LineageEventBranch   LineageEventKind = "branch"
+	LineageEventMerge    LineageEventKind = "merge"
+	LineageEventSnapshot LineageEventKind = "snapshot"
+	LineageEventClone    LineageEventKind = "clone"
+	LineageEventRestore  LineageEventKind = "restore"
+)
+
+// LineageEvent is one entry in a table's in-memory lineage log.
+type LineageEvent struct {
+	Kind LineageEventKind
+	Ts   types.TS
+	// Detail is a short human-readable description of the event, e.g. the
+	// branch name for LineageEventBranch or the source relation name for
+	// LineageEventMerge.
+	Detail string
+}
+
+// RecordLineageEvent appends ev to this table's in-memory lineage log.
+func (entry *TableEntry) RecordLineageEvent(ev LineageEvent) {
+	entry.lineage.Lock()
+	defer entry.lineage.Unlock()
+	entry.lineage.events = append(entry.lineage.events, ev)
+}
+
+// Lineage returns a copy of this table's in-memory lineage log, oldest
+// event first.
+func (entry *TableEntry) Lineage() []LineageEvent {
+	entry.lineage.RLock()
+	defer entry.lineage.RUnlock()
+	out := make([]LineageEvent, len(entry.lineage.events))
+	copy(out, entry.lineage.events)
+	return out
+}
+
+// UpdateDedupWatermark folds the incoming batch's zonemap into the table's
+// append-only dedup watermark, so later inserts above the new max can skip
+// the persisted-data dedup check. No-op for tables that aren't AppendOnly.
+func (entry *TableEntry) UpdateDedupWatermark(zm index.ZM) {
+	if !entry.GetLastestSchemaLocked(false).AppendOnly || !zm.IsInited() {
+		return
+	}
+	entry.dedupWatermark.Lock()
+	defer entry.dedupWatermark.Unlock()
+	if !entry.dedupWatermark.zm.IsInited() {
+		entry.dedupWatermark.zm = zm.Clone()
+		return
+	}
+	index.UpdateZM(entry.dedupWatermark.zm, zm.GetMinBuf())
+	index.UpdateZM(entry.dedupWatermark.zm, zm.GetMaxBuf())
+}
+
+// DedupWatermark returns the table's current append-only dedup watermark.
+// The returned zonemap is uninitialized if the table has no watermark yet.
+func (entry *TableEntry) DedupWatermark() index.ZM {
+	entry.dedupWatermark.RLock()
+	defer entry.dedupWatermark.RUnlock()
+	return entry.dedupWatermark.zm
 }
 
 func genTblFullName(tenantID uint32, name string) string {
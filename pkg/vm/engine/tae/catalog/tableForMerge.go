@@ -79,6 +79,7 @@ type MergeTable interface {
 	HasDropCommitted() bool
 
 	IsSpecialBigTable() bool // upgrade: old objects in big table is not merged by default
+	TenantID() uint32        // owning account, for per-tenant scheduling of merge IO
 }
 
 type TNTombstoneItem struct {
@@ -154,6 +155,10 @@ func (t TNMergeTable) ID() uint64 {
 	return t.TableEntry.ID
 }
 
+func (t TNMergeTable) TenantID() uint32 {
+	return t.GetDB().GetTenantID()
+}
+
 func (t TNMergeTable) IsSpecialBigTable() bool {
 	name := t.GetLastestSchema(false).Name
 	dbName := t.GetDB().GetName()
@@ -0,0 +1,101 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tpchbench
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+// lineitem column indexes, mirroring (a small subset of) TPC-H's lineitem
+// table: orderkey is the primary key, the rest are representative numeric
+// columns. This is not the full lineitem schema.
+const (
+	colOrderKey      = 0
+	colLineNumber    = 1
+	colQuantity      = 2
+	colExtendedPrice = 3
+)
+
+func newLineitemSchema() *catalog.Schema {
+	schema := catalog.NewEmptySchema(benchTableName)
+	_ = schema.AppendPKCol("l_orderkey", types.T_int64.ToType(), 0)
+	_ = schema.AppendCol("l_linenumber", types.T_int32.ToType())
+	_ = schema.AppendCol("l_quantity", types.T_float64.ToType())
+	_ = schema.AppendCol("l_extendedprice", types.T_float64.ToType())
+	schema.Extra.BlockMaxRows = 8192
+	schema.Extra.ObjectMaxBlocks = 10
+	_ = schema.Finalize(false)
+	return schema
+}
+
+// genLineitemBatch builds a batch of `rows` lineitem-shaped rows, keyed by
+// an ascending, 1-based l_orderkey so callers can look up any row in
+// [1, rows] by primary key.
+func genLineitemBatch(schema *catalog.Schema, rows int) *containers.Batch {
+	bat := containers.BuildBatch(schema.Attrs(), schema.Types(), containers.Options{Allocator: common.DefaultAllocator})
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < rows; i++ {
+		orderKey := int64(i + 1)
+		lineNumber := int32(i%7) + 1
+		quantity := float64(r.Intn(50) + 1)
+		extendedPrice := quantity * (float64(r.Intn(10000)) + 1)
+
+		bat.Vecs[colOrderKey].Append(orderKey, false)
+		bat.Vecs[colLineNumber].Append(lineNumber, false)
+		bat.Vecs[colQuantity].Append(quantity, false)
+		bat.Vecs[colExtendedPrice].Append(extendedPrice, false)
+	}
+	return bat
+}
+
+// loadLineitem creates the benchmark database and lineitem-shaped table if
+// they do not already exist, then appends a freshly generated batch of rows
+// rows into it.
+func loadLineitem(ctx context.Context, tae *db.DB, rows int) error {
+	txn, err := tae.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+
+	dbase, err := txn.GetDatabase(benchDBName)
+	if err != nil {
+		if dbase, err = txn.CreateDatabase(benchDBName, "", ""); err != nil {
+			return err
+		}
+	}
+
+	schema := newLineitemSchema()
+	rel, err := dbase.GetRelationByName(benchTableName)
+	if err != nil {
+		if rel, err = dbase.CreateRelation(schema); err != nil {
+			return err
+		}
+	}
+
+	bat := genLineitemBatch(schema, rows)
+	defer bat.Close()
+	if err = rel.Append(ctx, bat); err != nil {
+		return err
+	}
+
+	return txn.Commit(ctx)
+}
@@ -0,0 +1,210 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tpchbench is a load-and-run harness for exercising the TAE engine
+// at a configurable row count and reporting timings as JSON.
+//
+// It is not the official TPC-H or TPC-C benchmark: the schema is a
+// simplified, lineitem-shaped subset of columns, there is no query mix or
+// scale-factor compliance, and there is no TPC-C transaction load. It exists
+// to give this engine a repeatable, scriptable load/scan smoke test whose
+// output can be diffed across runs for regressions, not to produce an
+// official benchmark number.
+package tpchbench
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/spf13/cobra"
+)
+
+const (
+	benchDBName    = "tpchbench"
+	benchTableName = "lineitem"
+)
+
+// Report is the machine-readable result of a benchmark run.
+type Report struct {
+	Rows           int   `json:"rows"`
+	SampleLookups  int   `json:"sampleLookups"`
+	LoadMillis     int64 `json:"loadMillis"`
+	FullScanMillis int64 `json:"fullScanMillis"`
+	FullScanRows   int   `json:"fullScanRows"`
+	LookupMillis   int64 `json:"lookupMillis"`
+	LookupHits     int   `json:"lookupHits"`
+}
+
+// PrepareCommand returns the cobra command for "tpchbench".
+func PrepareCommand() *cobra.Command {
+	var dir string
+	var rows int
+	var lookups int
+
+	cmd := &cobra.Command{
+		Use:   "tpchbench",
+		Short: "Load a simplified lineitem-shaped table into TAE and report scan/lookup timings",
+		Long: "tpchbench opens (creating if necessary) a TAE store at --dir, appends a\n" +
+			"simplified lineitem-shaped table with --rows rows, then runs a full object\n" +
+			"scan and --lookups point lookups by primary key against it, reporting the\n" +
+			"timings as JSON on stdout. It is meant as a repeatable regression smoke\n" +
+			"test for the engine's load and read paths, not an official TPC-H result.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := Run(cmd.Context(), dir, rows, lookups)
+			if err != nil {
+				return err
+			}
+			return writeReport(cmd.OutOrStdout(), report)
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", "", "TAE store directory (created if it does not exist)")
+	cmd.Flags().IntVar(&rows, "rows", 10000, "number of rows to generate")
+	cmd.Flags().IntVar(&lookups, "lookups", 100, "number of by-primary-key lookups to sample")
+	_ = cmd.MarkFlagRequired("dir")
+
+	return cmd
+}
+
+func writeReport(out io.Writer, report *Report) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// Run opens a TAE store at dir, loads a simplified lineitem-shaped table of
+// rows rows into it, and times a full scan plus lookups point lookups by
+// primary key.
+func Run(ctx context.Context, dir string, rows int, lookups int) (*Report, error) {
+	if dir == "" {
+		return nil, moerr.NewInvalidInputNoCtx("tpchbench: --dir is required")
+	}
+	if rows <= 0 {
+		return nil, moerr.NewInvalidInputNoCtx("tpchbench: --rows must be positive")
+	}
+
+	tae, err := db.Open(ctx, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tae.Close()
+
+	report := &Report{Rows: rows, SampleLookups: lookups}
+
+	loadStart := time.Now()
+	if err = loadLineitem(ctx, tae, rows); err != nil {
+		return nil, err
+	}
+	report.LoadMillis = time.Since(loadStart).Milliseconds()
+
+	scanCount, scanElapsed, err := fullScan(ctx, tae)
+	if err != nil {
+		return nil, err
+	}
+	report.FullScanRows = scanCount
+	report.FullScanMillis = scanElapsed.Milliseconds()
+
+	hits, lookupElapsed, err := sampleLookups(ctx, tae, rows, lookups)
+	if err != nil {
+		return nil, err
+	}
+	report.LookupHits = hits
+	report.LookupMillis = lookupElapsed.Milliseconds()
+
+	return report, nil
+}
+
+// getBenchRelation fetches the benchmark database and table within an
+// already-started txn.
+func getBenchRelation(txn txnif.AsyncTxn) (handle.Relation, error) {
+	dbase, err := txn.GetDatabase(benchDBName)
+	if err != nil {
+		return nil, err
+	}
+	return dbase.GetRelationByName(benchTableName)
+}
+
+// fullScan walks every object of the benchmark table and counts its rows,
+// timing the walk.
+func fullScan(ctx context.Context, tae *db.DB) (count int, elapsed time.Duration, err error) {
+	txn, err := tae.StartTxn(nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		_ = txn.Commit(ctx)
+	}()
+
+	rel, err := getBenchRelation(txn)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start := time.Now()
+	it := rel.MakeObjectIt(false)
+	defer it.Close()
+	for it.Next() {
+		obj := it.GetObject()
+		for blkOffset := 0; blkOffset < obj.BlkCnt(); blkOffset++ {
+			var bat *containers.Batch
+			if err = obj.Scan(ctx, &bat, uint16(blkOffset), []int{0}, common.DefaultAllocator); err != nil {
+				return 0, 0, err
+			}
+			if bat == nil {
+				continue
+			}
+			count += bat.Vecs[0].Length()
+			bat.Close()
+		}
+	}
+	return count, time.Since(start), nil
+}
+
+// sampleLookups looks up `lookups` pseudo-random primary keys in [1, rows]
+// against the benchmark table, counting hits and timing the lookups.
+func sampleLookups(ctx context.Context, tae *db.DB, rows, lookups int) (hits int, elapsed time.Duration, err error) {
+	txn, err := tae.StartTxn(nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		_ = txn.Commit(ctx)
+	}()
+
+	rel, err := getBenchRelation(txn)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	r := rand.New(rand.NewSource(1))
+	start := time.Now()
+	for i := 0; i < lookups; i++ {
+		pk := int64(r.Intn(rows)) + 1
+		if _, _, lookupErr := rel.GetByFilter(ctx, handle.NewEQFilter(pk)); lookupErr == nil {
+			hits++
+		} else if !moerr.IsMoErrCode(lookupErr, moerr.ErrNotFound) {
+			return 0, 0, lookupErr
+		}
+	}
+	return hits, time.Since(start), nil
+}
@@ -70,6 +70,12 @@ type TableCompactStat struct {
 	flushDeadline time.Time
 	// lastMergeTime is the last merge time.
 	lastMergeTime time.Time
+
+	// mergeInputBytes/mergeOutputBytes are cumulative, since-process-start
+	// counters of the compressed size merge tasks read from and wrote back
+	// for this table. Not persisted across restarts.
+	mergeInputBytes  atomic.Int64
+	mergeOutputBytes atomic.Int64
 }
 
 func NewTableCompactStatWithRandomMergeTime() TableCompactStat {
@@ -119,6 +125,19 @@ func (s *TableCompactStat) GetLastMergeTime() time.Time {
 	return s.lastMergeTime
 }
 
+// AddMergeIO accumulates the compressed bytes a completed merge task read
+// (input) and wrote back (output) for this table.
+func (s *TableCompactStat) AddMergeIO(inputBytes, outputBytes int64) {
+	s.mergeInputBytes.Add(inputBytes)
+	s.mergeOutputBytes.Add(outputBytes)
+}
+
+// GetMergeIO returns the cumulative merge input/output bytes recorded by
+// AddMergeIO since this process started.
+func (s *TableCompactStat) GetMergeIO() (inputBytes, outputBytes int64) {
+	return s.mergeInputBytes.Load(), s.mergeOutputBytes.Load()
+}
+
 ////
 // Other utils
 ////
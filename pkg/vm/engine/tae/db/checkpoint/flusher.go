@@ -479,6 +479,11 @@ func foreachAobjBefore(_ context.Context,
 	}
 }
 
+// collectTableMemUsage builds flusher.objMemSizeList, the per-round
+// candidate list checkFlushConditionAndFire iterates, sorted by asize
+// (appendable-object dirty size) descending so the largest in-memory
+// table flushes first. lastCkp is the lower fence foreachAobjBefore uses
+// so this only sizes objects created since the last checkpoint.
 func (flusher *flushImpl) collectTableMemUsage(entry *logtail.DirtyTreeEntry, lastCkp types.TS) (memPressureRate float64) {
 	// reuse the list
 	flusher.objMemSizeList = flusher.objMemSizeList[:0]
@@ -1062,6 +1062,16 @@ func (c *checkpointCleaner) getCkpReader(
 	)
 }
 
+// GetPITRs and GetSnapshot are this package's reachability source: every
+// object referenced by a live snapshot, PITR window, or the active
+// backup-protection TS is kept; everything else a merged checkpoint no
+// longer points at is deletable. It's a full mark-sweep recomputed from
+// mo_catalog metadata each GC pass, not an incremental refcount scheme.
+//
+// Data-branch clone tables (pkg/frontend/databranchutils) aren't part of
+// this reachability set: a branch can reference a base table's objects
+// without appearing in snapshotMeta's tracking, so nothing here currently
+// protects an object that's only still reachable through a branch.
 func (c *checkpointCleaner) GetPITRs() (*logtail.PitrInfo, error) {
 	c.mutation.Lock()
 	defer c.mutation.Unlock()
@@ -82,7 +82,19 @@ func (e *executor) executeFor(entry *catalog.TableEntry, task mergeTask) (succes
 		return
 	}
 
-	return e.scheduleMergeObjects(slices.Clone(objs), entry, task.isTombstone, level, note, doneCB)
+	var inputBytes int64
+	for _, o := range objs {
+		inputBytes += int64(o.GetObjectStats().Size())
+	}
+	outputBytes := int64(task.eSize)
+	ioCB := &taskObserver{f: func() {
+		entry.Stats.AddMergeIO(inputBytes, outputBytes)
+		if doneCB != nil {
+			doneCB.OnExecDone(nil)
+		}
+	}}
+
+	return e.scheduleMergeObjects(slices.Clone(objs), entry, task.isTombstone, level, note, ioCB)
 }
 
 func (e *executor) scheduleMergeObjects(
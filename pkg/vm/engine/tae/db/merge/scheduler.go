@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/rscthrottler"
+	"github.com/matrixorigin/matrixone/pkg/common/tenantthrottler"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -37,6 +38,12 @@ import (
 const (
 	bigDataTaskCntThreshold   = 4
 	objectOpsTriggerThreshold = 5
+
+	// tenantIORatePerWeight/tenantIOCapPerWeight bound how many bytes/sec of
+	// merge IO a weight-1.0 account may sustain/burst, so one busy tenant's
+	// backlog can't starve another's merges on the same node.
+	tenantIORatePerWeight = 256 * 1024 * 1024
+	tenantIOCapPerWeight  = 1024 * 1024 * 1024
 )
 
 type mergeTask struct {
@@ -77,6 +84,7 @@ type MergeScheduler struct {
 
 	baseInterval time.Duration
 	rc           rscthrottler.RSCThrottler
+	tenantIO     *tenantthrottler.TenantIOScheduler
 	executor     MergeTaskExecutor
 
 	clock Clock
@@ -101,6 +109,8 @@ func NewMergeScheduler(
 		pad:            newLaunchPad(clock),
 		defaultTrigger: DefaultTrigger.Clone(),
 
+		tenantIO: tenantthrottler.NewTenantIOScheduler(tenantIORatePerWeight, tenantIOCapPerWeight),
+
 		clock: clock,
 	}
 
@@ -134,6 +144,12 @@ func (a *MergeScheduler) PatchTestRscController(rc rscthrottler.RSCThrottler) {
 	a.rc = rc
 }
 
+// SetTenantIOWeight adjusts an account's share of the node's merge IO budget.
+// Accounts default to weight 1.0.
+func (a *MergeScheduler) SetTenantIOWeight(accountID uint32, weight float64) {
+	a.tenantIO.SetWeight(accountID, weight)
+}
+
 func (a *MergeScheduler) Stop() {
 	if a.stopped.CompareAndSwap(false, true) {
 		ch := a.stopCh.Load()
@@ -1069,6 +1085,15 @@ func (a *MergeScheduler) doSched(todo *todoItem) {
 	afterGather := a.clock.Now()
 	// Schedule tasks
 	for _, task := range tasks {
+		if float64(task.eSize) <= a.tenantIO.MaxTokens(todo.table.TenantID()) &&
+			!a.tenantIO.Acquire(todo.table.TenantID(), float64(task.eSize)) {
+			// this account is over its fair share of merge IO for now; try
+			// again on the next pass instead of starving other tenants.
+			continue
+		}
+		// A task larger than the account's bucket cap can never be
+		// acquired through normal refill, so let it through unthrottled
+		// rather than skip it on every pass forever.
 		task.doneCB = a.taskObserverFactory(todo.table, task.eSize)
 		if a.executor.ExecuteFor(todo.table, task) {
 			a.rc.Acquire(int64(task.eSize))
@@ -22,6 +22,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/rscthrottler"
+	"github.com/matrixorigin/matrixone/pkg/common/tenantthrottler"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -324,6 +325,51 @@ func TestScheduler(t *testing.T) {
 
 }
 
+func TestSchedulerTenantIOOversizedTaskNotStarved(t *testing.T) {
+	db := catalog.MockDBEntryWithAccInfo(1, 2000)
+	table := catalog.ToMergeTable(catalog.MockTableEntryWithDB(db, 2000))
+
+	dummySource := &dummyCatalogSource{initTables: []catalog.MergeTable{table}}
+
+	sched := NewMergeScheduler(
+		1*time.Millisecond,
+		dummySource,
+		&dummyExecutor{},
+		NewStdClock(),
+	)
+	// Shrink the tenant IO cap far below any real task's estimated size.
+	// Without a starvation fallback, Acquire would fail for this task on
+	// every pass and the table would never get merged.
+	sched.tenantIO = tenantthrottler.NewTenantIOScheduler(1, 1)
+
+	sched.Start()
+	defer sched.Stop()
+
+	time.Sleep(3 * time.Millisecond)
+
+	trigger := NewMMsgTaskTrigger(table).WithByUser(true)
+	trigger.WithAssignedTasks([]mergeTask{
+		{
+			objs: []*objectio.ObjectStats{
+				newTestObjectStats(t, 1, 2, 300*common.Const1MBytes, 1000, 1, nil, 0),
+			},
+			note:  "oversized for tenant cap",
+			level: 1,
+		},
+	})
+	sched.SendTrigger(trigger)
+
+	var answer *QueryAnswer
+	for i := 0; i < 100; i++ {
+		answer = sched.Query(table)
+		if answer.DataMergeCnt == 1 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	require.Equal(t, 1, answer.DataMergeCnt)
+}
+
 func TestLaunchPad(t *testing.T) {
 	pad := newLaunchPad(NewStdClock())
 	cata := catalog.MockCatalog(nil)
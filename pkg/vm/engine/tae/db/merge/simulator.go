@@ -132,6 +132,14 @@ func (c *simRscController) Available() int64 {
 	return avail
 }
 
+func (c *simRscController) Pressure() float64 {
+	limit := c.limit.Load()
+	if limit <= 0 {
+		return 0
+	}
+	return float64(c.reserved) / float64(limit)
+}
+
 // endregion: resource controller
 
 // region: executor
@@ -768,6 +776,8 @@ func (t *STable) HasDropCommitted() bool { return false }
 
 func (t *STable) IsSpecialBigTable() bool { return false }
 
+func (t *STable) TenantID() uint32 { return 0 }
+
 func (t *STable) AddDataLocked(data SData) {
 	stats := data.GetObjectStats()
 	lv := stats.GetLevel()
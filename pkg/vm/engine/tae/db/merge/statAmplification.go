@@ -0,0 +1,75 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// region: Amplification
+
+// AmplificationStats reports write- and space-amplification proxies for a
+// table. WriteAmpFactor is derived from the table's cumulative merge I/O
+// counters (common.TableCompactStat.GetMergeIO); SpaceAmpFactor is derived
+// from VacuumStats.DataVacuumPercent, the fraction of live data rows sitting
+// in hollow-but-uncompacted objects.
+type AmplificationStats struct {
+	MergeInputBytes  int64
+	MergeOutputBytes int64
+
+	// WriteAmpFactor is MergeInputBytes/MergeOutputBytes: how many bytes of
+	// merge IO were read for every byte eventually kept. 1 means merges
+	// never re-read data that didn't also get rewritten back; it climbs as
+	// small/overlapping objects get merged over and over.
+	WriteAmpFactor float64
+
+	// SpaceAmpFactor is 1/(1-DataVacuumPercent): how much on-disk data is
+	// kept around per logically-live row.
+	SpaceAmpFactor float64
+}
+
+func (s *AmplificationStats) String() string {
+	return fmt.Sprintf(
+		"MergeIO: in=%s out=%s, WriteAmp: %.2fx, SpaceAmp: %.2fx",
+		common.HumanReadableBytes(int(s.MergeInputBytes)),
+		common.HumanReadableBytes(int(s.MergeOutputBytes)),
+		s.WriteAmpFactor,
+		s.SpaceAmpFactor,
+	)
+}
+
+// CalculateAmplificationStats combines a table's cumulative merge I/O
+// counters with its current vacuum stats into write-/space-amplification
+// proxies. It does no IO of its own.
+func CalculateAmplificationStats(
+	mergeInputBytes, mergeOutputBytes int64,
+	vacuum *VacuumStats,
+) *AmplificationStats {
+	ret := &AmplificationStats{
+		MergeInputBytes:  mergeInputBytes,
+		MergeOutputBytes: mergeOutputBytes,
+	}
+	if mergeOutputBytes > 0 {
+		ret.WriteAmpFactor = float64(mergeInputBytes) / float64(mergeOutputBytes)
+	}
+	if vacuum.DataVacuumPercent < 1 {
+		ret.SpaceAmpFactor = 1 / (1 - vacuum.DataVacuumPercent)
+	}
+	return ret
+}
+
+// endregion: Amplification
@@ -0,0 +1,35 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateAmplificationStats(t *testing.T) {
+	stats := CalculateAmplificationStats(300, 100, &VacuumStats{DataVacuumPercent: 0.5})
+	require.Equal(t, int64(300), stats.MergeInputBytes)
+	require.Equal(t, int64(100), stats.MergeOutputBytes)
+	require.InDelta(t, 3.0, stats.WriteAmpFactor, 1e-9)
+	require.InDelta(t, 2.0, stats.SpaceAmpFactor, 1e-9)
+}
+
+func TestCalculateAmplificationStats_zeroOutput(t *testing.T) {
+	stats := CalculateAmplificationStats(0, 0, &VacuumStats{})
+	require.Equal(t, float64(0), stats.WriteAmpFactor)
+	require.InDelta(t, 1.0, stats.SpaceAmpFactor, 1e-9)
+}
@@ -23,12 +23,14 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/dbutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/logservicedriver"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/wal"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
@@ -120,7 +122,14 @@ func Open(
 
 	logutil.Info(Phase_Open + "-open-wal-start")
 	if opts.WalClientFactory != nil {
-		db.Wal = wal.NewLogserviceHandle(opts.WalClientFactory)
+		walConfigOpts := make([]logservicedriver.ConfigOption, 0, 2)
+		if opts.WalClientBufSize > 0 {
+			walConfigOpts = append(walConfigOpts, logservicedriver.WithConfigOptClientBufSize(opts.WalClientBufSize))
+		}
+		if opts.WalClientMaxEntryCount > 0 {
+			walConfigOpts = append(walConfigOpts, logservicedriver.WithConfigOptClientMaxEntryCount(opts.WalClientMaxEntryCount))
+		}
+		db.Wal = wal.NewLogserviceHandle(opts.WalClientFactory, walConfigOpts...)
 	} else {
 		db.Wal = wal.NewLocalHandle(dirname, WALDir, nil)
 	}
@@ -137,9 +146,16 @@ func Open(
 		return nil
 	})
 
+	// Wrap the shared object FileService with fencing so a replica that
+	// lost its lease during a failover can't keep writing appendable
+	// objects after a successor, with a higher ReplicaID, has taken over
+	// (see flushTableTail.go's WriteFenceToken stamp and
+	// FencedFileService's doc comment).
+	fencedObjectFs := fileservice.NewFencedFileService(opts.Fs)
+
 	db.Runtime = dbutils.NewRuntime(
 		dbutils.WithRuntimeTransferTable(transferTable),
-		dbutils.WithRuntimeObjectFS(opts.Fs),
+		dbutils.WithRuntimeObjectFS(fencedObjectFs),
 		dbutils.WithRuntimeLocalFS(opts.LocalFs),
 		dbutils.WithRuntimeTmpFS(opts.TmpFs),
 		dbutils.WithRuntimeSmallPool(dbutils.MakeDefaultSmallPool("small-vector-pool")),
@@ -9380,6 +9380,69 @@ func TestDedupSnapshot3(t *testing.T) {
 	assert.NoError(t, txn.Commit(context.Background()))
 }
 
+// TestDedupWatermarkAfterBulkLoad covers the gap where AddDataFiles used to
+// leave the table's dedup watermark behind: a bulk-loaded object's keys must
+// widen the watermark too, or a later BatchDedup call above the stale
+// watermark but inside the bulk-loaded range would wrongly skip the
+// persisted-data check and let a real duplicate through.
+func TestDedupWatermarkAfterBulkLoad(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithQuickScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.Extra.BlockMaxRows = 10
+	schema.Extra.ObjectMaxBlocks = 3
+	schema.AppendOnly = true
+	tae.BindSchema(schema)
+	testutil.CreateRelation(t, tae.DB, "db", schema, true)
+
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+
+	// normal inserts for keys [0, 10) warm up the watermark the usual way.
+	head := bat.Window(0, 10)
+	txn, rel := tae.GetRelation()
+	assert.NoError(t, rel.BatchDedup(head.Vecs[3]))
+	assert.NoError(t, rel.Append(context.Background(), head))
+	assert.NoError(t, txn.Commit(context.Background()))
+
+	// bulk-load an object covering keys [20, 30) without going through
+	// Append/DoBatchDedup.
+	tail := bat.Window(20, 10)
+	nobjid := objectio.NewObjectid()
+	name := objectio.BuildObjectNameWithObjectID(&nobjid)
+	writer, err := ioutil.NewBlockWriterNew(tae.Runtime.Fs, name, 0, nil, false)
+	assert.NoError(t, err)
+	writer.SetPrimaryKey(3)
+	_, err = writer.WriteBatch(containers.ToCNBatch(tail))
+	assert.NoError(t, err)
+	_, _, err = writer.Sync(context.Background())
+	assert.NoError(t, err)
+	statsVec := containers.MakeVector(types.T_varchar.ToType(), common.DefaultAllocator)
+	defer statsVec.Close()
+	ss := writer.GetObjectStats()
+	statsVec.Append(ss[:], false)
+
+	txn, rel = tae.GetRelation()
+	assert.NoError(t, rel.AddDataFiles(context.Background(), statsVec))
+	assert.NoError(t, txn.Commit(context.Background()))
+
+	// a key inside the bulk-loaded range is still above the old [0, 10)
+	// watermark, so it must be rejected by the persisted-data check rather
+	// than skipped.
+	dup := bat.Window(25, 1)
+	txn, rel = tae.GetRelation()
+	err = rel.BatchDedup(dup.Vecs[3])
+	assert.Error(t, err)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry))
+	_ = txn.Rollback(context.Background())
+}
+
 func TestSoftDeleteRollback(t *testing.T) {
 	defer testutils.AfterTest(t)()
 	ctx := context.Background()
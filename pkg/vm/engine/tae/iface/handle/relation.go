@@ -60,6 +60,36 @@ type Relation interface {
 	FillInWorkspaceDeletes(blkID types.Blockid, view **nulls.Nulls, deleteStartOffset uint64) error
 
 	GetDB() (Database, error)
+
+	// CreateBranch creates a new relation named name in the same database,
+	// populated with the objects of this relation that are visible as of
+	// fromTS. Shared objects are attached by reference via AddDataFiles /
+	// AddPersistedTombstoneFile, so a branch does not copy the underlying
+	// data.
+	CreateBranch(ctx context.Context, name string, fromTS types.TS) (Relation, error)
+	// DropBranch drops the relation named name from the same database. It
+	// does not verify that name was created by CreateBranch.
+	DropBranch(ctx context.Context, name string) error
+	// MergeBranch reconciles branch into this relation by primary key. A row
+	// whose key exists only in branch is appended here. A row whose key
+	// exists in both and already agrees is left alone. A row whose key
+	// exists in both but disagrees is reported as a MergeConflict and left
+	// untouched; applying the fix, if any, is left to the caller.
+	//
+	// MergeBranch does not walk either relation's MVCC history, so it
+	// cannot tell a one-sided edit from a genuine concurrent conflict: any
+	// disagreement, on either side, is reported.
+	MergeBranch(ctx context.Context, branch Relation) ([]MergeConflict, error)
+}
+
+// MergeConflict describes a primary key for which MergeBranch found
+// disagreeing, non-key column values on the branch and target relations.
+// BranchRow and TargetRow are indexed the same as the target's
+// Schema.Attrs()/Types(), with the primary key column left nil.
+type MergeConflict struct {
+	PK        any
+	BranchRow []any
+	TargetRow []any
 }
 
 type RelationIt interface {
@@ -126,6 +126,39 @@ type TxnChanger interface {
 
 	CommittingInRecovery() error
 	CommitInRecovery(ctx context.Context) error
+
+	// RefreshSnapshot advances this txn's read timestamp to the most
+	// recently committed timestamp, for REFRESH SNAPSHOT. It fails if the
+	// txn has already written anything, since moving the read snapshot
+	// after a write could silently change what that write conflicts with.
+	RefreshSnapshot(ctx context.Context) error
+
+	// DeferConstraintCheck registers check to run during PrepareCommit,
+	// instead of at the point the constraint it covers would otherwise be
+	// validated. It supports DEFERRABLE INITIALLY DEFERRED constraints,
+	// where a caller needs rows inserted out of their eventual-consistency
+	// order (e.g. a child row before its parent) to be accepted as long as
+	// the constraint holds by commit time. Checks run in registration
+	// order; the first error aborts the commit.
+	//
+	// This only defers to commit. There is no savepoint execution in this
+	// engine to defer to a RELEASE SAVEPOINT instead, so a check registered
+	// inside a savepoint's scope still only runs at the enclosing txn's
+	// commit.
+	DeferConstraintCheck(check DeferredConstraintCheck)
+	// RunDeferredConstraintChecks runs every check registered via
+	// DeferConstraintCheck, in registration order, stopping at the first
+	// error.
+	RunDeferredConstraintChecks(ctx context.Context) error
+}
+
+// DeferredConstraintCheck is one constraint validation postponed to commit
+// by DeferConstraintCheck. Name identifies the constraint in error messages;
+// Check does the actual validation against the transaction's write set plus
+// committed data, returning a non-nil error if the constraint is violated.
+type DeferredConstraintCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
 }
 
 type TxnWriter interface {
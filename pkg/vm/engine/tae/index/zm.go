@@ -449,6 +449,15 @@ func (zm ZM) getValue(buf []byte) any {
 	panic(fmt.Sprintf("unsupported type: %v", zm.GetType()))
 }
 
+// updateMinString and updateMaxString always truncate to a fixed 30 bytes:
+// zm[30] and zm[61] pack the stored length into 5 bits (GetMinBuf/GetMaxBuf
+// mask with 0x1f), so there is no spare room in this layout to store a
+// longer, per-block-adaptive prefix for columns with long shared prefixes
+// (e.g. URL-like keys) without changing ZM's on-disk format, which is
+// written into every object's metadata today. A variable prefix length
+// would need either a new ZM encoding version or a separate, longer
+// min/max side-channel alongside it - out of scope for a change that has
+// to stay read-compatible with every ZM already persisted to disk.
 func (zm ZM) updateMinString(v []byte) {
 	size := len(v)
 	if size > 30 {
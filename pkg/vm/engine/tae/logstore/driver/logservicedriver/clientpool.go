@@ -209,6 +209,9 @@ func (c *wrappedClient) Append(
 			break
 		}
 		retryTimes++
+		if c.pool.cfg.OnAppendRetry != nil {
+			c.pool.cfg.OnAppendRetry(retryTimes, err)
+		}
 		if time.Since(now) > c.pool.cfg.MaxTimeout {
 			break
 		}
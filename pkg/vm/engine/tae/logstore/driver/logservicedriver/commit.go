@@ -30,6 +30,9 @@ func (d *LogServiceDriver) Append(e *entry.Entry) (err error) {
 	if !d.canWrite() {
 		return ErrNeedReplayForWrite
 	}
+	if int(d.pendingCommitters.Load()) >= d.config.MaxPendingCommitters {
+		return ErrTooMuchPenddings
+	}
 
 	_, err = d.commitLoop.Enqueue(e)
 	return
@@ -50,6 +53,7 @@ func (d *LogServiceDriver) getCommitter() *groupCommitter {
 // this function flushes the current committer to the append queue and
 // creates a new committer as the current committer
 func (d *LogServiceDriver) flushCurrentCommitter() {
+	d.pendingCommitters.Add(1)
 	d.asyncCommit(d.committer)
 	d.commitWaitQueue <- d.committer
 	d.committer = getCommitter()
@@ -86,6 +90,7 @@ func (d *LogServiceDriver) asyncCommit(committer *groupCommitter) {
 				zap.Error(err2),
 			)
 		}
+		d.exitDegraded()
 	})
 }
 
@@ -116,6 +121,7 @@ func (d *LogServiceDriver) onWaitCommitted(items []any, nextQueue chan any) {
 	for _, item := range items {
 		committer := item.(*groupCommitter)
 		committer.Wait()
+		d.pendingCommitters.Add(-1)
 		committer.PutbackClient()
 		committer.NotifyCommitted()
 		d.recordCommitInfo(committer)
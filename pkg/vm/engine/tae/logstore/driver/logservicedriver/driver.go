@@ -77,6 +77,17 @@ type LogServiceDriver struct {
 
 	config Config
 
+	// pendingCommitters counts group commits handed off to logservice that
+	// have not been acked yet. Append rejects new writes with
+	// ErrTooMuchPenddings once it reaches config.MaxPendingCommitters.
+	pendingCommitters atomic.Int32
+
+	// degraded and degradedSince track whether logservice appends are
+	// currently failing and being retried. It is set from an append retry
+	// and cleared on the next successful commit.
+	degraded      atomic.Bool
+	degradedSince atomic.Int64
+
 	clientPool *clientPool
 	committer  *groupCommitter
 
@@ -103,7 +114,6 @@ func NewLogServiceDriver(cfg *Config) *LogServiceDriver {
 	}))
 
 	d := &LogServiceDriver{
-		clientPool:          newClientPool(cfg),
 		committer:           getCommitter(),
 		sequenceNumberState: newSequenceNumberState(),
 		commitWaitQueue:     make(chan any, 10000),
@@ -111,6 +121,9 @@ func NewLogServiceDriver(cfg *Config) *LogServiceDriver {
 		workers:             pool,
 	}
 
+	cfg.OnAppendRetry = d.onAppendRetry
+	d.clientPool = newClientPool(cfg)
+
 	d.config = *cfg
 	d.ctx, d.cancel = context.WithCancel(context.Background())
 	d.commitLoop = sm.NewSafeQueue(10000, 10000, d.onCommitIntents)
@@ -308,3 +321,47 @@ func (d *LogServiceDriver) canWrite() bool {
 	replayState := d.replayState.Load()
 	return replayState != nil && replayState.done && replayState.mode == driver.ReplayMode_ReplayForWrite
 }
+
+// onAppendRetry is invoked whenever a single append attempt to logservice
+// fails and is about to be retried. It flags the driver as degraded for as
+// long as logservice keeps failing; IsDegraded lets callers (e.g. a
+// read-only mode switch) react to a blip in progress instead of only
+// finding out after the retry window is exhausted and the process fatals.
+func (d *LogServiceDriver) onAppendRetry(attempt int, err error) {
+	if d.degraded.CompareAndSwap(false, true) {
+		d.degradedSince.Store(time.Now().UnixNano())
+		logutil.Error(
+			"Wal-Degraded-Enter",
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+	}
+}
+
+// exitDegraded clears the degraded flag set by onAppendRetry once a commit
+// has gone through again.
+func (d *LogServiceDriver) exitDegraded() {
+	if d.degraded.CompareAndSwap(true, false) {
+		since := d.degradedSince.Load()
+		logutil.Info(
+			"Wal-Degraded-Recovered",
+			zap.Duration("duration", time.Since(time.Unix(0, since))),
+		)
+	}
+}
+
+// IsDegraded returns true while logservice appends are failing and being
+// retried, i.e. the driver is inside its retry window.
+func (d *LogServiceDriver) IsDegraded() bool {
+	return d.degraded.Load()
+}
+
+// DegradedSince returns when the driver entered its current degraded
+// window. ok is false if the driver is not currently degraded.
+func (d *LogServiceDriver) DegradedSince() (since time.Time, ok bool) {
+	ns := d.degradedSince.Load()
+	if !d.degraded.Load() || ns == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(0, ns), true
+}
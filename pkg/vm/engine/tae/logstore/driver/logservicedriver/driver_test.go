@@ -203,6 +203,39 @@ func TestReplay2(t *testing.T) {
 	driver.Close()
 }
 
+func TestDriverDegradedState(t *testing.T) {
+	d := &LogServiceDriver{}
+	assert.False(t, d.IsDegraded())
+	_, ok := d.DegradedSince()
+	assert.False(t, ok)
+
+	d.onAppendRetry(1, fmt.Errorf("mock append error"))
+	assert.True(t, d.IsDegraded())
+	since, ok := d.DegradedSince()
+	assert.True(t, ok)
+	assert.False(t, since.IsZero())
+
+	// further retries while already degraded keep the original since time.
+	d.onAppendRetry(2, fmt.Errorf("mock append error"))
+	since2, ok := d.DegradedSince()
+	assert.True(t, ok)
+	assert.Equal(t, since, since2)
+
+	d.exitDegraded()
+	assert.False(t, d.IsDegraded())
+	_, ok = d.DegradedSince()
+	assert.False(t, ok)
+}
+
+func TestConfigMaxPendingCommittersDefault(t *testing.T) {
+	store := NewMockBackend()
+	cfg := NewConfig("", WithConfigMockClient(store))
+	assert.Equal(t, DefaultMaxPendingCommitters, cfg.MaxPendingCommitters)
+
+	cfg2 := NewConfig("", WithConfigMockClient(store), WithConfigOptMaxPendingCommitters(7))
+	assert.Equal(t, 7, cfg2.MaxPendingCommitters)
+}
+
 // func Test_TokenController(t *testing.T) {
 // 	c := newTokenController(100)
 // 	var wg sync.WaitGroup
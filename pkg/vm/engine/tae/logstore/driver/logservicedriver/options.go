@@ -26,11 +26,12 @@ import (
 )
 
 const (
-	DefaultMaxClient           = 100
-	DefaultClientBufSize       = 2 * mpool.MB
-	DefaultMaxTimeout          = time.Minute * 3
-	DefaultOneTryTimeout       = time.Minute
-	DefaultClientMaxEntryCount = 50
+	DefaultMaxClient            = 100
+	DefaultClientBufSize        = 2 * mpool.MB
+	DefaultMaxTimeout           = time.Minute * 3
+	DefaultOneTryTimeout        = time.Minute
+	DefaultClientMaxEntryCount  = 50
+	DefaultMaxPendingCommitters = 2000
 )
 
 type Config struct {
@@ -44,6 +45,19 @@ type Config struct {
 	ClientRetryInterval time.Duration
 	ClientRetryDuration time.Duration
 
+	// MaxPendingCommitters bounds how many group commits can be in flight
+	// (submitted to logservice but not yet acked) before Append starts
+	// rejecting new writes with ErrTooMuchPenddings. It caps how much a
+	// write burst can buffer while logservice is slow or briefly
+	// unreachable, instead of growing the wait queue without limit.
+	MaxPendingCommitters int
+
+	// OnAppendRetry, if set, is called every time a single append attempt
+	// to logservice fails and is about to be retried. It is used to flag
+	// the driver as degraded while logservice is having trouble but the
+	// retry window has not yet been exhausted.
+	OnAppendRetry func(attempt int, err error)
+
 	ClientFactory LogServiceClientFactory
 	IsMockBackend bool
 }
@@ -94,12 +108,24 @@ func WithConfigOptClientBufSize(bufSize int) ConfigOption {
 	}
 }
 
+func WithConfigOptClientMaxEntryCount(maxCount int) ConfigOption {
+	return func(cfg *Config) {
+		cfg.ClientMaxEntryCount = maxCount
+	}
+}
+
 func WithConfigOptMaxTimeout(timeout time.Duration) ConfigOption {
 	return func(cfg *Config) {
 		cfg.MaxTimeout = timeout
 	}
 }
 
+func WithConfigOptMaxPendingCommitters(maxPending int) ConfigOption {
+	return func(cfg *Config) {
+		cfg.MaxPendingCommitters = maxPending
+	}
+}
+
 func WithConfigMockClient(backend MockBackend) ConfigOption {
 	return func(cfg *Config) {
 		cfg.IsMockBackend = true
@@ -158,6 +184,9 @@ func (cfg *Config) fillDefaults() {
 	if cfg.ClientRetryDuration <= 0 {
 		cfg.ClientRetryDuration = DefaultRetryDuration
 	}
+	if cfg.MaxPendingCommitters <= 0 {
+		cfg.MaxPendingCommitters = DefaultMaxPendingCommitters
+	}
 }
 
 func (cfg *Config) validate() {
@@ -44,10 +44,13 @@ type StoreImpl struct {
 
 func NewLogserviceHandle(
 	factory logservicedriver.LogServiceClientFactory,
+	opts ...logservicedriver.ConfigOption,
 ) *StoreImpl {
 	cfg := logservicedriver.NewConfig(
 		"",
-		logservicedriver.WithConfigOptClientFactory(factory),
+		append([]logservicedriver.ConfigOption{
+			logservicedriver.WithConfigOptClientFactory(factory),
+		}, opts...)...,
 	)
 	driver := logservicedriver.NewLogServiceDriver(&cfg)
 	return NewStore(driver)
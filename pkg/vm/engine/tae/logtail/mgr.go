@@ -93,6 +93,15 @@ type Manager struct {
 	orderedList []*txnWithLogtails
 	collectWg   sync.WaitGroup
 	collectPool *ants.Pool
+
+	// externalSubscribers are additional, read-only consumers of committed
+	// logtail (e.g. a CDC tap) layered on top of the single logtailCallback
+	// above, which remains reserved for the push-logtail service. They never
+	// receive closeCB: by the time generateLogtailWithTxn runs, tails are
+	// already-built logtail.TableLogtail protobuf values, independent of the
+	// containers.Batch objects closeCB releases, so sharing them read-only
+	// across subscribers is safe.
+	externalSubscribers sync.Map // name (string) -> func(from, to timestamp.Timestamp, tails ...logtail.TableLogtail)
 }
 
 func NewManager(
@@ -211,6 +220,43 @@ func (mgr *Manager) generateLogtailWithTxn(txn *txnWithLogtails) {
 	} else {
 		txn.closeCB()
 	}
+	mgr.notifyExternalSubscribers(txn)
+}
+
+func (mgr *Manager) notifyExternalSubscribers(txn *txnWithLogtails) {
+	hasSubscriber := false
+	mgr.externalSubscribers.Range(func(_, _ any) bool {
+		hasSubscriber = true
+		return false
+	})
+	if !hasSubscriber || len(*txn.tails) == 0 {
+		return
+	}
+	to := txn.txn.GetPrepareTS().ToTimestamp()
+	mgr.externalSubscribers.Range(func(_, value any) bool {
+		value.(func(to timestamp.Timestamp, tails ...logtail.TableLogtail))(to, *txn.tails...)
+		return true
+	})
+}
+
+// RegisterExternalSubscriber registers an additional, read-only consumer of
+// committed logtail, keyed by name. Unlike RegisterCallback (reserved for the
+// push-logtail service, one slot), any number of external subscribers can be
+// registered at once; this is the tap point a future streaming CDC subsystem
+// would sit behind. It is infrastructure only: there is no ordered-stream API,
+// resumable checkpointing, or gRPC transport yet, those remain separate,
+// materially larger follow-up work.
+func (mgr *Manager) RegisterExternalSubscriber(
+	name string,
+	cb func(to timestamp.Timestamp, tails ...logtail.TableLogtail),
+) {
+	mgr.externalSubscribers.Store(name, cb)
+}
+
+// UnregisterExternalSubscriber removes a subscriber previously registered via
+// RegisterExternalSubscriber.
+func (mgr *Manager) UnregisterExternalSubscriber(name string) {
+	mgr.externalSubscribers.Delete(name)
 }
 
 // OnEndPrePrepare is a listener for TxnManager. When a txn completes PrePrepare,
@@ -44,6 +44,18 @@ func WithWalClientFactory(factory logservicedriver.LogServiceClientFactory) func
 	}
 }
 
+func WithWalClientBufSize(size int) func(*Options) {
+	return func(opts *Options) {
+		opts.WalClientBufSize = size
+	}
+}
+
+func WithWalClientMaxEntryCount(count int) func(*Options) {
+	return func(opts *Options) {
+		opts.WalClientMaxEntryCount = count
+	}
+}
+
 func WithCheckpointMinCount(count int64) func(*Options) {
 	return func(opts *Options) {
 		if opts.CheckpointCfg == nil {
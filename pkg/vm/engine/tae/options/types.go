@@ -80,6 +80,13 @@ type Options struct {
 	EnableApplyTableData      bool
 	GCTimeCheckerFactory      func(any) func(*types.TS) bool
 
+	// WalClientBufSize and WalClientMaxEntryCount bound how many WAL
+	// entries the logservice driver batches into a single group-commit
+	// before flushing. 0 keeps the driver's own defaults
+	// (logservicedriver.DefaultClientBufSize / DefaultClientMaxEntryCount).
+	WalClientBufSize       int
+	WalClientMaxEntryCount int
+
 	Fs                fileservice.FileService                  `toml:"-"`
 	LocalFs           fileservice.FileService                  `toml:"-"`
 	TmpFs             *fileservice.TmpFileService              `toml:"-"`
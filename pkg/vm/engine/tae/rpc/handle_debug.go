@@ -603,6 +603,9 @@ func (h *Handle) HandleGetChangedTableList(
 	return nil, nil
 }
 
+// HandleFlushTable always flushes up to the current TN clock
+// (h.db.TxnMgr.Now()); cmd_util.FlushTable has no ts field for a caller to
+// request an earlier bound.
 func (h *Handle) HandleFlushTable(
 	ctx context.Context,
 	meta txn.TxnMeta,
@@ -295,13 +295,12 @@ func (arg *mergeShowArg) Run() error {
 				WithFitPolynomialDegree(arg.lnFitPolyDegree),
 		)
 		out.WriteString("\n")
-		OutputVacuumStats(
-			&out,
-			mergeTable,
-			merge.NewVacuumOpts().
-				WithEnableDetail(arg.vacuumDetail).
-				WithCheckBigOnly(arg.vacuumCheckBigOnly),
-		)
+		vacuumOpts := merge.NewVacuumOpts().
+			WithEnableDetail(arg.vacuumDetail).
+			WithCheckBigOnly(arg.vacuumCheckBigOnly)
+		OutputVacuumStats(&out, mergeTable, vacuumOpts)
+		out.WriteString("\n")
+		OutputAmplificationStats(&out, arg.tbl, vacuumOpts)
 	}
 	arg.ctx.resp.Payload = out.Bytes()
 	return nil
@@ -362,6 +361,21 @@ func OutputVacuumStats(
 	out.WriteString(fmt.Sprintf("\nvacuum stats: %s", stats.String()))
 }
 
+func OutputAmplificationStats(
+	out *bytes.Buffer,
+	tbl *catalog.TableEntry,
+	opts *merge.VacuumOpts,
+) {
+	vacuum, err := merge.CalculateVacuumStats(context.Background(), catalog.ToMergeTable(tbl), opts, time.Now())
+	if err != nil {
+		out.WriteString(fmt.Sprintf("\namplification stats: %s", err))
+		return
+	}
+	inputBytes, outputBytes := tbl.Stats.GetMergeIO()
+	stats := merge.CalculateAmplificationStats(inputBytes, outputBytes, vacuum)
+	out.WriteString(fmt.Sprintf("\namplification stats: %s", stats.String()))
+}
+
 func (arg *mergeShowArg) String() string {
 	t := "*"
 	if arg.tbl != nil {
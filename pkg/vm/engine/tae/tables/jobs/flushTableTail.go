@@ -719,7 +719,10 @@ func (task *flushTableTailTask) mergeAObjs(ctx context.Context, isTombstone bool
 			return err
 		}
 	}
-	_, _, err = writer.Sync(ctx)
+	_, _, err = writer.Sync(ctx, objectio.WriteOptions{
+		Type: objectio.WriteFenceToken,
+		Val:  task.rt.Options.Shard.ReplicaID,
+	})
 	if err != nil {
 		return err
 	}
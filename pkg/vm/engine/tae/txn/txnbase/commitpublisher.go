@@ -0,0 +1,96 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"hash/fnv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+// CommitCallback is invoked once per (tableID, commitTS) pair after the
+// owning txn has been durably committed. digest identifies this delivery
+// for the handler's own dedup bookkeeping; it is derived from the txn ID,
+// table ID and commit TS, not from the row data the txn wrote, since the
+// commit-apply path a CommitPublisher hooks into doesn't carry the
+// committed batch past this point.
+type CommitCallback func(tableID uint64, commitTS types.TS, digest []byte)
+
+// CommitCursor is the caller-owned bookmark a CommitPublisher resumes
+// from. A CommitPublisher only reads it once, at construction, and calls
+// Advance after each delivery; it never persists Cursor itself, so the
+// caller is responsible for making Get/Advance durable (e.g. backing
+// them with a small file on the local fileservice) if deliveries must
+// not be repeated across a process restart.
+type CommitCursor struct {
+	Get     func() types.TS
+	Advance func(types.TS)
+}
+
+// CommitPublisher is a TxnCommitListener that turns durable txn commits
+// into a Handler callback per table touched, skipping anything at or
+// before Cursor so a restarted process doesn't redeliver commits the
+// previous instance already handed off. It is meant for outbox-style
+// integrations that need a commit-level signal without standing up a
+// full CDC/logtail subscription.
+//
+// It does not itself guarantee exactly-once delivery to the outside
+// world: Handler can still run and Advance can still be lost (e.g. on a
+// crash between the two), in which case the next process redelivers
+// that commit. Handler should be idempotent against that case.
+type CommitPublisher struct {
+	NoopCommitListener
+	cursor  CommitCursor
+	handler CommitCallback
+}
+
+// NewCommitPublisher builds a CommitPublisher that calls handler for
+// every table touched by a committed txn whose commit TS is after
+// cursor.Get(), then advances cursor past it.
+func NewCommitPublisher(cursor CommitCursor, handler CommitCallback) *CommitPublisher {
+	return &CommitPublisher{cursor: cursor, handler: handler}
+}
+
+func (p *CommitPublisher) OnApplyCommit(txn txnif.AsyncTxn) {
+	memo := txn.GetMemo()
+	if memo == nil || !memo.HasAnyTableDataChanges() {
+		return
+	}
+
+	commitTS := txn.GetCommitTS()
+	last := p.cursor.Get()
+	if commitTS.LE(&last) {
+		return
+	}
+
+	for _, table := range memo.GetDirty().Tables {
+		p.handler(table.ID, commitTS, commitDigest(txn.GetID(), table.ID, commitTS))
+	}
+	p.cursor.Advance(commitTS)
+}
+
+func commitDigest(txnID string, tableID uint64, commitTS types.TS) []byte {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(txnID))
+	tsBytes := commitTS.ToString()
+	_, _ = h.Write([]byte(tsBytes))
+	var tableIDBytes [8]byte
+	for i := range tableIDBytes {
+		tableIDBytes[i] = byte(tableID >> (8 * i))
+	}
+	_, _ = h.Write(tableIDBytes[:])
+	return h.Sum(nil)
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeferConstraintCheckRunsInOrder(t *testing.T) {
+	txn := MockTxnReaderWithNow()
+
+	var order []string
+	txn.DeferConstraintCheck(txnif.DeferredConstraintCheck{
+		Name: "fk_a",
+		Check: func(ctx context.Context) error {
+			order = append(order, "fk_a")
+			return nil
+		},
+	})
+	txn.DeferConstraintCheck(txnif.DeferredConstraintCheck{
+		Name: "fk_b",
+		Check: func(ctx context.Context) error {
+			order = append(order, "fk_b")
+			return nil
+		},
+	})
+
+	require.NoError(t, txn.RunDeferredConstraintChecks(context.Background()))
+	require.Equal(t, []string{"fk_a", "fk_b"}, order)
+}
+
+func TestDeferConstraintCheckStopsAtFirstFailure(t *testing.T) {
+	txn := MockTxnReaderWithNow()
+
+	var ran []string
+	txn.DeferConstraintCheck(txnif.DeferredConstraintCheck{
+		Name: "fk_a",
+		Check: func(ctx context.Context) error {
+			ran = append(ran, "fk_a")
+			return errors.New("violated")
+		},
+	})
+	txn.DeferConstraintCheck(txnif.DeferredConstraintCheck{
+		Name: "fk_b",
+		Check: func(ctx context.Context) error {
+			ran = append(ran, "fk_b")
+			return nil
+		},
+	})
+
+	err := txn.RunDeferredConstraintChecks(context.Background())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "fk_a")
+	require.Equal(t, []string{"fk_a"}, ran)
+}
+
+func TestDeferConstraintCheckBlocksPrepareCommit(t *testing.T) {
+	txn := MockTxnReaderWithNow()
+	txn.DeferConstraintCheck(txnif.DeferredConstraintCheck{
+		Name: "uq_email",
+		Check: func(ctx context.Context) error {
+			return errors.New("duplicate email")
+		},
+	})
+
+	err := txn.PrepareCommit()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "uq_email")
+}
@@ -122,6 +122,13 @@ func (rel *TxnRelation) AlterTable(context.Context, *apipb.AlterTableReq) (err e
 func (rel *TxnRelation) FillInWorkspaceDeletes(blkID types.Blockid, view **nulls.Nulls, deleteStartOffset uint64) error {
 	return nil
 }
+func (rel *TxnRelation) CreateBranch(ctx context.Context, name string, fromTS types.TS) (handle.Relation, error) {
+	return nil, nil
+}
+func (rel *TxnRelation) DropBranch(ctx context.Context, name string) error { return nil }
+func (rel *TxnRelation) MergeBranch(ctx context.Context, branch handle.Relation) ([]handle.MergeConflict, error) {
+	return nil, nil
+}
 func (obj *TxnObject) Reset() {
 	obj.Txn = nil
 	obj.Rel = nil
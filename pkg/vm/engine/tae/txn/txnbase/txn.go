@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"runtime/trace"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -91,6 +92,9 @@ type Txn struct {
 	PrepareRollbackFn func(txnif.AsyncTxn) error
 	ApplyCommitFn     func(txnif.AsyncTxn) error
 	ApplyRollbackFn   func(txnif.AsyncTxn) error
+
+	deferredChecksMu sync.Mutex
+	deferredChecks   []txnif.DeferredConstraintCheck
 }
 
 func NewTxn(mgr *TxnManager, store txnif.TxnStore, txnId []byte, start, snapshot types.TS) *Txn {
@@ -154,6 +158,49 @@ func (txn *Txn) SetApplyRollbackFn(fn func(txnif.AsyncTxn) error)   { txn.ApplyR
 func (txn *Txn) SetDedupType(dedupType txnif.DedupPolicy)           { txn.DedupType = dedupType }
 func (txn *Txn) GetDedupType() txnif.DedupPolicy                    { return txn.DedupType }
 
+// RefreshSnapshot advances this txn's read timestamp to the most recently
+// committed timestamp, so a long-running interactive transaction can see
+// fresher data without aborting and restarting (REFRESH SNAPSHOT). It only
+// succeeds while this txn's write set is still empty: once a write has
+// landed, moving the read snapshot underneath it risks a write validated
+// against the old snapshot silently disagreeing with the new one, so any
+// txn that has written anything is rejected outright rather than risk that.
+func (txn *Txn) RefreshSnapshot(ctx context.Context) (err error) {
+	if state := txn.getTxnState(); state != txnif.TxnStateActive {
+		return moerr.NewTxnNotActiveNoCtx(txnif.TxnStrState(state))
+	}
+	if !txn.Store.IsReadonly() {
+		return moerr.NewInternalErrorNoCtxf("cannot refresh snapshot: txn %s has pending writes", txn.String())
+	}
+	newTS := *txn.Mgr.MaxCommittedTS.Load()
+	txn.SetStartTS(newTS)
+	txn.SetSnapshotTS(newTS)
+	return nil
+}
+
+// DeferConstraintCheck registers check to run during PrepareCommit rather
+// than immediately, for DEFERRABLE INITIALLY DEFERRED constraints.
+func (txn *Txn) DeferConstraintCheck(check txnif.DeferredConstraintCheck) {
+	txn.deferredChecksMu.Lock()
+	defer txn.deferredChecksMu.Unlock()
+	txn.deferredChecks = append(txn.deferredChecks, check)
+}
+
+// RunDeferredConstraintChecks runs every check registered via
+// DeferConstraintCheck, in registration order, stopping at the first error.
+func (txn *Txn) RunDeferredConstraintChecks(ctx context.Context) (err error) {
+	txn.deferredChecksMu.Lock()
+	checks := txn.deferredChecks
+	txn.deferredChecksMu.Unlock()
+
+	for _, check := range checks {
+		if err = check.Check(ctx); err != nil {
+			return moerr.NewInternalErrorNoCtxf("deferred constraint %q failed: %v", check.Name, err)
+		}
+	}
+	return nil
+}
+
 //The state transition of transaction is as follows:
 // 1PC: TxnStateActive--->TxnStatePreparing--->TxnStateCommitted/TxnStateRollbacked
 //		TxnStateActive--->TxnStatePreparing--->TxnStateRollbacking--->TxnStateRollbacked
@@ -336,6 +383,9 @@ func (txn *Txn) DoneWithErr(err error, isAbort bool) {
 
 func (txn *Txn) PrepareCommit() (err error) {
 	logutil.Debugf("Prepare Commite %X", txn.ID)
+	if err = txn.RunDeferredConstraintChecks(txn.GetContext()); err != nil {
+		return err
+	}
 	if txn.PrepareCommitFn != nil {
 		err = txn.PrepareCommitFn(txn)
 		return
@@ -82,12 +82,18 @@ type TxnCommitListener interface {
 	OnBeginPrePrepare(txnif.AsyncTxn)
 	OnEndPrePrepare(txnif.AsyncTxn)
 	OnEndPrepareWAL(txnif.AsyncTxn)
+	// OnApplyCommit is called once a committing txn has been durably
+	// written to the WAL and applied to the in-memory catalog, i.e. after
+	// its commit can no longer be lost. It is not called for rollbacks.
+	OnApplyCommit(txnif.AsyncTxn)
 }
 
 type NoopCommitListener struct{}
 
 func (bl *NoopCommitListener) OnBeginPrePrepare(txn txnif.AsyncTxn) {}
 func (bl *NoopCommitListener) OnEndPrePrepare(txn txnif.AsyncTxn)   {}
+func (bl *NoopCommitListener) OnEndPrepareWAL(txn txnif.AsyncTxn)   {}
+func (bl *NoopCommitListener) OnApplyCommit(txn txnif.AsyncTxn)     {}
 
 type batchTxnCommitListener struct {
 	listeners []TxnCommitListener
@@ -120,6 +126,12 @@ func (bl *batchTxnCommitListener) OnEndPrepareWAL(txn txnif.AsyncTxn) {
 	}
 }
 
+func (bl *batchTxnCommitListener) OnApplyCommit(txn txnif.AsyncTxn) {
+	for _, l := range bl.listeners {
+		l.OnApplyCommit(txn)
+	}
+}
+
 type TxnStoreFactory = func() txnif.TxnStore
 type TxnFactory = func(*TxnManager, txnif.TxnStore, []byte, types.TS, types.TS) txnif.AsyncTxn
 
@@ -508,6 +520,11 @@ func (mgr *TxnManager) onPreparRollback(txn txnif.AsyncTxn) {
 	_ = txn.PrepareRollback()
 }
 
+// onBindPrepareTimeStamp is where every committing txn serializes: it
+// takes mgr.ts.mu to allocate the next prepare TS one at a time and
+// asserts it strictly increases over mgr.prevPrepareTS, since TAE's MVCC
+// visibility (checkpoints, zonemaps, compaction, GetSnapshot's
+// reachability scan) assumes TS is a single totally-ordered domain.
 func (mgr *TxnManager) onBindPrepareTimeStamp(op *OpTxn) (ts types.TS) {
 	// Replay txn is always prepared
 	if op.IsReplay() {
@@ -595,6 +612,7 @@ func (mgr *TxnManager) on1PCApply(op *OpTxn) {
 		if err = op.Txn.ApplyCommit(); err != nil {
 			panic(err)
 		}
+		mgr.CommitListener.OnApplyCommit(op.Txn)
 	case OpRollback:
 		isAbort = true
 		if err = op.Txn.ApplyRollback(); err != nil {
@@ -29,6 +29,12 @@ import (
 
 var ErrRWConflict = moerr.NewTxnRWConflictNoCtx()
 
+// readWriteConfilictCheck always takes the wait-and-recheck path below
+// (needWait) rather than failing fast: a per-txn serializable isolation
+// level that opts this check into fail-fast was prototyped and then
+// reverted (see this function's commit history) because no SQL syntax,
+// session variable, or txn-option entry point ever set it, leaving it dead
+// code. Adding a real entry point is a separate, materially larger change.
 func readWriteConfilictCheck(entry *catalog.ObjectEntry, ts types.TS, inqueue bool) (err error) {
 	lastNode := entry.GetLatestNode()
 	if !lastNode.HasDropIntent() {
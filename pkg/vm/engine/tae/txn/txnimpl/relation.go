@@ -17,6 +17,7 @@ package txnimpl
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -373,3 +374,154 @@ func (h *txnRelation) AlterTable(ctx context.Context, req *apipb.AlterTableReq)
 func (h *txnRelation) FillInWorkspaceDeletes(blkID types.Blockid, view **nulls.Nulls, deleteStartOffset uint64) error {
 	return h.table.FillInWorkspaceDeletes(blkID, view, deleteStartOffset)
 }
+
+func (h *txnRelation) CreateBranch(ctx context.Context, name string, fromTS types.TS) (branch handle.Relation, err error) {
+	db, err := h.GetDB()
+	if err != nil {
+		return nil, err
+	}
+	schema := h.table.entry.GetLastestSchema(false).Clone()
+	schema.Name = name
+	branch, err = db.CreateRelation(schema)
+	if err != nil {
+		return nil, err
+	}
+	if err = attachVisibleObjects(ctx, h.table.entry, branch, fromTS); err != nil {
+		return nil, err
+	}
+	h.table.entry.RecordLineageEvent(catalog.LineageEvent{
+		Kind:   catalog.LineageEventBranch,
+		Ts:     fromTS,
+		Detail: name,
+	})
+	return branch, nil
+}
+
+func (h *txnRelation) DropBranch(ctx context.Context, name string) (err error) {
+	db, err := h.GetDB()
+	if err != nil {
+		return err
+	}
+	_, err = db.DropRelationByName(name)
+	return err
+}
+
+func (h *txnRelation) MergeBranch(ctx context.Context, branch handle.Relation) (conflicts []handle.MergeConflict, err error) {
+	schema := h.table.entry.GetLastestSchema(false)
+	pkIdx := schema.GetSingleSortKeyIdx()
+	colIdxes := make([]int, 0, len(schema.ColDefs))
+	for _, col := range schema.ColDefs {
+		if col.IsPhyAddr() {
+			continue
+		}
+		colIdxes = append(colIdxes, col.Idx)
+	}
+
+	insertBatch := containers.BuildBatch(schema.Attrs(), schema.Types(), containers.Options{Allocator: common.DefaultAllocator})
+	defer insertBatch.Close()
+
+	it := branch.MakeObjectIt(false)
+	defer it.Close()
+	for it.Next() {
+		obj := it.GetObject()
+		for blkOffset := 0; blkOffset < obj.BlkCnt(); blkOffset++ {
+			var bat *containers.Batch
+			if err = obj.Scan(ctx, &bat, uint16(blkOffset), colIdxes, common.DefaultAllocator); err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				continue
+			}
+			pkVec := bat.Vecs[pkIdx]
+			for row := 0; row < pkVec.Length(); row++ {
+				pkVal := pkVec.Get(row)
+				id, offset, getErr := h.GetByFilter(ctx, handle.NewEQFilter(pkVal))
+				if getErr != nil {
+					if !moerr.IsMoErrCode(getErr, moerr.ErrNotFound) {
+						return nil, getErr
+					}
+					for i, vec := range bat.Vecs {
+						insertBatch.Vecs[i].Append(vec.Get(row), vec.IsNull(row))
+					}
+					continue
+				}
+				branchRow := make([]any, len(bat.Vecs))
+				targetRow := make([]any, len(bat.Vecs))
+				disagrees := false
+				for i := range bat.Vecs {
+					if i == pkIdx {
+						continue
+					}
+					bv := bat.Vecs[i].Get(row)
+					tv, _, gvErr := h.GetValue(id, offset, uint16(colIdxes[i]), false)
+					if gvErr != nil {
+						return nil, gvErr
+					}
+					branchRow[i] = bv
+					targetRow[i] = tv
+					if !reflect.DeepEqual(bv, tv) {
+						disagrees = true
+					}
+				}
+				if disagrees {
+					conflicts = append(conflicts, handle.MergeConflict{
+						PK:        pkVal,
+						BranchRow: branchRow,
+						TargetRow: targetRow,
+					})
+				}
+			}
+		}
+	}
+	if insertBatch.Length() > 0 {
+		if err = h.Append(ctx, insertBatch); err != nil {
+			return nil, err
+		}
+	}
+	h.table.entry.RecordLineageEvent(catalog.LineageEvent{
+		Kind:   catalog.LineageEventMerge,
+		Ts:     h.Txn.GetStartTS(),
+		Detail: fmt.Sprintf("merged %s, %d conflict(s)", branch.String(), len(conflicts)),
+	})
+	return conflicts, nil
+}
+
+// attachVisibleObjects attaches, by reference, every object of src that is
+// visible as of fromTS onto branch. It does not copy any object data: data
+// objects are handed to branch via AddDataFiles, tombstone objects via
+// AddPersistedTombstoneFile, the same zero-copy mechanisms used to attach
+// objects written out-of-band (e.g. by CN).
+func attachVisibleObjects(ctx context.Context, src *catalog.TableEntry, branch handle.Relation, fromTS types.TS) (err error) {
+	statsVec := containers.MakeVector(types.T_varchar.ToType(), common.DefaultAllocator)
+	defer statsVec.Close()
+
+	it := src.MakeDataObjectIt()
+	for it.Next() {
+		obj := it.Item()
+		if !obj.VisibleByTS(fromTS) {
+			continue
+		}
+		stats := obj.GetObjectStats()
+		statsVec.Append(stats[:], false)
+	}
+	it.Release()
+	if statsVec.Length() > 0 {
+		if err = branch.AddDataFiles(ctx, statsVec); err != nil {
+			return err
+		}
+	}
+
+	tombstoneIt := src.MakeTombstoneObjectIt()
+	for tombstoneIt.Next() {
+		obj := tombstoneIt.Item()
+		if !obj.VisibleByTS(fromTS) {
+			continue
+		}
+		if _, err = branch.AddPersistedTombstoneFile(obj.AsCommonID(), *obj.GetObjectStats()); err != nil {
+			tombstoneIt.Release()
+			return err
+		}
+	}
+	tombstoneIt.Release()
+	return nil
+}
@@ -309,6 +309,9 @@ func (store *txnStore) BatchDedup(dbId, id uint64, pk containers.Vector) (err er
 	return db.BatchDedup(id, pk)
 }
 
+// Append, together with RangeDelete below, is also how every UPDATE is
+// executed: a tombstone for the old row plus an Append of the new one
+// carrying every column, changed or not.
 func (store *txnStore) Append(ctx context.Context, dbId, id uint64, data *containers.Batch) error {
 	if err := store.IncreateWriteCnt("append"); err != nil {
 		return err
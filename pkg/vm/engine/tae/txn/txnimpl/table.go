@@ -865,9 +865,20 @@ func (tbl *txnTable) Append(ctx context.Context, data *containers.Batch) (err er
 	return
 }
 func (tbl *txnTable) AddDataFiles(ctx context.Context, stats containers.Vector) (err error) {
+	schema := tbl.dataTable.schema
 	return stats.Foreach(func(v any, isNull bool, row int) error {
 		s := objectio.ObjectStats(v.([]byte))
-		return tbl.addObjsWithMetaLoc(ctx, s, false)
+		if err := tbl.addObjsWithMetaLoc(ctx, s, false); err != nil {
+			return err
+		}
+		if schema.HasPK() && !schema.IsSecondaryIndexTable() {
+			// Bulk-loaded objects bypass Append/DoBatchDedup, so the dedup
+			// watermark has to be advanced here too, or canSkipPersistedDedup
+			// would let a later insert skip the check against rows this call
+			// just persisted.
+			tbl.entry.UpdateDedupWatermark(s.SortKeyZoneMap())
+		}
+		return nil
 	}, nil)
 }
 func (tbl *txnTable) addObjsWithMetaLoc(
@@ -1342,9 +1353,9 @@ func (tbl *txnTable) DedupWorkSpace(key containers.Vector, isTombstone bool) (er
 }
 
 func (tbl *txnTable) DoBatchDedup(key containers.Vector) (err error) {
-	index := NewSimpleTableIndex()
+	sidx := NewSimpleTableIndex()
 	//Check whether primary key is duplicated.
-	if err = index.BatchInsert(
+	if err = sidx.BatchInsert(
 		tbl.dataTable.schema.GetSingleSortKey().Name,
 		key,
 		0,
@@ -1360,11 +1371,40 @@ func (tbl *txnTable) DoBatchDedup(key containers.Vector) (err error) {
 		logutil.Infof("DoBatchDedup BatchInsert failed2 %v", err)
 		return
 	}
+
+	pkType := key.GetType()
+	inZM := index.NewZM(pkType.Oid, pkType.Scale)
+	zmErr := index.BatchUpdateZM(inZM, key.GetDownstreamVector())
+
+	if zmErr == nil && tbl.canSkipPersistedDedup(inZM) {
+		// the whole incoming key range is above the table's tracked watermark,
+		// so no persisted row can collide with it.
+		tbl.entry.UpdateDedupWatermark(inZM)
+		return
+	}
 	//Check whether primary key is duplicated in txn's snapshot data.
-	err = tbl.DedupSnapByPK(context.Background(), key, false)
+	if err = tbl.DedupSnapByPK(context.Background(), key, false); err == nil && zmErr == nil {
+		tbl.entry.UpdateDedupWatermark(inZM)
+	}
 	return
 }
 
+// canSkipPersistedDedup reports whether inZM's key range is entirely above
+// the table's append-only dedup watermark, meaning no persisted row can
+// possibly collide with it and DedupSnapByPK can be skipped. Always false
+// for tables that aren't AppendOnly or that haven't built up a watermark yet
+// (e.g. right after a restart, since the watermark isn't persisted).
+func (tbl *txnTable) canSkipPersistedDedup(inZM index.ZM) bool {
+	if !tbl.dataTable.schema.AppendOnly {
+		return false
+	}
+	wm := tbl.entry.DedupWatermark()
+	if !wm.IsInited() {
+		return false
+	}
+	return compute.Compare(wm.GetMaxBuf(), inZM.GetMinBuf(), wm.GetType(), wm.GetScale(), inZM.GetScale()) < 0
+}
+
 func (tbl *txnTable) BatchDedupLocal(bat *containers.Batch) (err error) {
 	err = tbl.dataTable.BatchDedupLocal(bat)
 	return
@@ -1228,6 +1228,7 @@ var forceShuffleReader forceShuffleReaderConfig
 type prefetchOnSubscribedConfig struct {
 	sync.RWMutex
 	overridden bool
+	patterns   []string
 	regexps    []*regexp.Regexp
 }
 
@@ -1288,6 +1289,7 @@ func SetPrefetchOnSubscribed(patterns []string) error {
 	if patterns == nil {
 		prefetchOnSubscribed.Lock()
 		prefetchOnSubscribed.overridden = false
+		prefetchOnSubscribed.patterns = nil
 		prefetchOnSubscribed.regexps = nil
 		prefetchOnSubscribed.Unlock()
 		return nil
@@ -1307,12 +1309,49 @@ func SetPrefetchOnSubscribed(patterns []string) error {
 	)
 
 	prefetchOnSubscribed.Lock()
+	prefetchOnSubscribed.patterns = append([]string{}, patterns...)
 	prefetchOnSubscribed.regexps = regexps
 	prefetchOnSubscribed.overridden = true
 	prefetchOnSubscribed.Unlock()
 	return nil
 }
 
+// maxAutoPrefetchOnSubscribedPatterns bounds the pattern set grown by
+// AddPrefetchOnSubscribedPattern, so that a long-running cluster that keeps
+// creating tables doesn't grow this list without limit; the oldest pattern
+// is evicted first once the cap is hit.
+const maxAutoPrefetchOnSubscribedPatterns = 512
+
+// AddPrefetchOnSubscribedPattern merges pattern into the existing
+// prefetch-on-subscribed set instead of replacing it wholesale like
+// SetPrefetchOnSubscribed does. It exists for callers that incrementally
+// discover one pattern at a time (e.g. priming the catalog cache for a
+// single newly created table) and must not clobber patterns an operator
+// configured earlier via mo_ctl.
+func AddPrefetchOnSubscribedPattern(pattern string) error {
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return moerr.NewInternalErrorNoCtxf("compile pattern %q: %v", pattern, err)
+	}
+
+	prefetchOnSubscribed.Lock()
+	defer prefetchOnSubscribed.Unlock()
+	for _, p := range prefetchOnSubscribed.patterns {
+		if p == pattern {
+			return nil
+		}
+	}
+
+	prefetchOnSubscribed.patterns = append(prefetchOnSubscribed.patterns, pattern)
+	prefetchOnSubscribed.regexps = append(prefetchOnSubscribed.regexps, r)
+	if over := len(prefetchOnSubscribed.patterns) - maxAutoPrefetchOnSubscribedPatterns; over > 0 {
+		prefetchOnSubscribed.patterns = prefetchOnSubscribed.patterns[over:]
+		prefetchOnSubscribed.regexps = prefetchOnSubscribed.regexps[over:]
+	}
+	prefetchOnSubscribed.overridden = true
+	return nil
+}
+
 func GetPrefetchOnSubscribed() (bool, []*regexp.Regexp) {
 	prefetchOnSubscribed.RLock()
 	defer prefetchOnSubscribed.RUnlock()
@@ -1326,6 +1365,22 @@ func GetPrefetchOnSubscribed() (bool, []*regexp.Regexp) {
 	return true, regexps
 }
 
+// GetPrefetchOnSubscribedPatterns returns the raw pattern strings backing
+// the current prefetch-on-subscribed set, for callers (e.g. the cross-CN
+// catalog-cache-priming broadcast) that need to forward the merged set
+// rather than just match against it.
+func GetPrefetchOnSubscribedPatterns() []string {
+	prefetchOnSubscribed.RLock()
+	defer prefetchOnSubscribed.RUnlock()
+
+	if !prefetchOnSubscribed.overridden {
+		return nil
+	}
+	patterns := make([]string, len(prefetchOnSubscribed.patterns))
+	copy(patterns, prefetchOnSubscribed.patterns)
+	return patterns
+}
+
 type FilterHint struct {
 	Must        bool
 	BloomFilter []byte